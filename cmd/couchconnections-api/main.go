@@ -15,7 +15,10 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gobuffalo/packr/v2"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/version"
+	"github.com/sebastianrosch/couchconnections/internal/calendar"
 	"github.com/sebastianrosch/couchconnections/internal/config"
 	"github.com/sebastianrosch/couchconnections/internal/grpc"
 	"github.com/sebastianrosch/couchconnections/internal/rest"
@@ -35,48 +38,69 @@ func main() {
 	// Get the config.
 	var httpPort, grpcPort, host string = config.Get().HTTPPort, config.Get().GRPCPort, config.Get().Host
 
-	s, err := store.NewMongoStore(
-		config.Get().DatabaseURI,
-		config.Get().DatabaseName,
-		config.Get().DatabaseUsername,
-		config.Get().DatabasePassword,
-	)
+	s, err := getStore(config.Get().StoreType)
 	if err != nil {
-		logger.Error(err, "couldn't create MongoDB store")
+		logger.Error(err, "couldn't create store", "type", config.Get().StoreType)
 		os.Exit(2)
 	}
+	logger.Info("using store", "name", s.Name())
 
 	// Setup the context.
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	s.CreateEvent("How viruses spread", "Epidemologist talks about how viruses spread")
-
-	events, _ := s.GetAllEvents()
-	for _, event := range events {
-		fmt.Print(event)
-	}
-
 	httpClient := getHTTPClient()
 
-	tokenDecoder := auth.NewJWTTokenDecoder(config.Get().AuthJwksURL)
+	jwks := auth.NewJWKSCache(&auth.JWKSCacheConfig{
+		URL:                config.Get().AuthJwksURL,
+		DefaultTTL:         config.Get().AuthJwksDefaultTTL,
+		MinRefetchInterval: config.Get().AuthJwksMinRefetchInterval,
+	}, httpClient)
+	jwks.Start(ctx)
+	tokenDecoder := auth.NewJWTTokenDecoderWithJWKS(jwks, &auth.JWTTokenDecoderConfig{
+		AllowedAlgorithms: config.Get().AuthJwtAlgorithms,
+		Issuers:           config.Get().AuthIssuers,
+		Audiences:         config.Get().AuthAudiences,
+		RequiredScopes:    config.Get().AuthRequiredScopes,
+		Leeway:            config.Get().AuthTokenLeeway,
+	})
+	tokenValidator, err := getTokenValidator(config.Get().AuthTokenValidator, tokenDecoder, httpClient)
+	if err != nil {
+		logger.Error(err, "couldn't create token validator", "type", config.Get().AuthTokenValidator)
+		os.Exit(2)
+	}
 	userInfoRetriever := auth.NewUserInfoRetriever(config.Get().AuthUserInfoEndpoint, httpClient)
+	userInfoCache, err := auth.NewUserInfoCache(userInfoRetriever, &auth.UserInfoCacheConfig{
+		MaxEntries: config.Get().AuthUserInfoCacheSize,
+		TTL:        config.Get().AuthUserInfoCacheTTL,
+	}, prometheus.DefaultRegisterer)
+	if err != nil {
+		logger.Error(err, "couldn't create user info cache")
+		os.Exit(2)
+	}
 	metadata := service.NewMetadata()
 	whitelist := []string{"/v1.CouchConnections/GetVersion"}
-	authContext := &auth.BearerTokenContext{}
-	authenticator := auth.NewAuthenticator(logger, whitelist, tokenDecoder, userInfoRetriever, metadata, authContext)
+	authContext := &auth.APIKeyTokenContext{}
+	apiKeys := servicev1.NewAPIKeyResolver(s)
+	authenticator := auth.NewAuthenticator(logger, whitelist, tokenValidator, userInfoCache, apiKeys, metadata, authContext)
+
+	authorizer, err := auth.NewAuthorizer(config.Get().AuthCapability, nil)
+	if err != nil {
+		logger.Error(err, "couldn't create authorizer")
+		os.Exit(2)
+	}
 
 	// Configure the service implementation.
-	v1Service := &servicev1.CouchConnectionsService{}
+	v1Service := servicev1.NewCouchConnectionsService(s, config.Get().JoinLinkWindow, authorizer)
 
 	// Set up a router to host all handlers on the same port.
-	router := setupRouter(ctx, logger, host, grpcPort)
+	router := setupRouter(ctx, logger, host, grpcPort, s, authenticator, authorizer)
 
 	// Start the HTTP server.
 	httpServer := startHTTPServer(logger, host, httpPort, router)
 
 	// Start the gRPC server.
-	grpcServer := startgRPCServer(ctx, logger, host, grpcPort, v1Service, authenticator)
+	grpcServer := startgRPCServer(ctx, logger, host, grpcPort, v1Service, authenticator, authorizer)
 	if grpcServer == nil {
 		return
 	}
@@ -102,7 +126,10 @@ func setupRouter(
 	ctx context.Context,
 	logger logr.Logger,
 	host string,
-	grpcPort string) *mux.Router {
+	grpcPort string,
+	s store.Store,
+	authenticator *auth.TokenAuthenticator,
+	authorizer *auth.Authorizer) *mux.Router {
 	app := packr.New("app", "../../public/app/dist/login-demo")
 	swaggerv1 := packr.New("swagger", "../../api/swagger/v1")
 	swaggerui := packr.New("swaggerui", "../../swaggerui")
@@ -115,6 +142,8 @@ func setupRouter(
 
 	router := mux.NewRouter()
 	router.PathPrefix("/docs/").Handler(docsRouter)
+	router.Path("/metrics").Handler(promhttp.Handler())
+	calendar.NewHandler(logger, s, authenticator, authorizer).Register(router)
 	router.PathPrefix("/api/").Handler(http.StripPrefix("/api", rest.GetHandler(ctx, logger, host, grpcPort)))
 	router.PathPrefix("/").Handler(http.FileServer(app))
 
@@ -146,14 +175,19 @@ func startgRPCServer(
 	logger logr.Logger,
 	host, grpcPort string,
 	v1Service *servicev1.CouchConnectionsService,
-	authenticator *auth.TokenAuthenticator) *ggrpc.Server {
+	authenticator *auth.TokenAuthenticator,
+	authorizer *auth.Authorizer) *ggrpc.Server {
+	grpcServer, err := grpc.GetServer(ctx, logger, v1Service, authenticator, authorizer)
+	if err != nil {
+		logger.Error(err, "failed to create gRPC server")
+		return nil
+	}
 	// Only the gRPC server needs a different port, but as it is only internal it doesn't matter.
 	listener, err := net.Listen("tcp", host+":"+grpcPort)
 	if err != nil {
 		logger.Error(err, "failed to bind gRPC server")
 		return nil
 	}
-	grpcServer := grpc.GetServer(ctx, logger, v1Service, authenticator)
 	go func() {
 		logger.Info("starting gRPC server", "addr", host+":"+grpcPort)
 		err := grpcServer.Serve(listener)
@@ -166,6 +200,56 @@ func startgRPCServer(
 	return grpcServer
 }
 
+// getStore returns the store for the configured store type.
+func getStore(storeType string) (store.Store, error) {
+	switch storeType {
+	case "memory":
+		return store.NewMemoryStore(), nil
+	case "mongo":
+		return store.NewMongoStore(
+			config.Get().DatabaseURI,
+			config.Get().DatabaseName,
+			config.Get().DatabaseUsername,
+			config.Get().DatabasePassword,
+		)
+	default:
+		return nil, fmt.Errorf("unknown store type %q", storeType)
+	}
+}
+
+// getTokenValidator returns the validator of access tokens.
+func getTokenValidator(validatorType string, tokenDecoder *auth.JWTTokenDecoder, httpClient *http.Client) (auth.TokenValidator, error) {
+	introspection := func() (*auth.IntrospectionValidator, error) {
+		if config.Get().AuthIntrospectionEndpoint == "" {
+			return nil, fmt.Errorf("token validator %q requires an introspection endpoint", validatorType)
+		}
+		return auth.NewIntrospectionValidator(&auth.IntrospectionConfig{
+			Endpoint:       config.Get().AuthIntrospectionEndpoint,
+			ClientID:       config.Get().AuthIntrospectionClientID,
+			ClientSecret:   config.Get().AuthIntrospectionClientSecret,
+			Issuers:        config.Get().AuthIssuers,
+			Audiences:      config.Get().AuthAudiences,
+			RequiredScopes: config.Get().AuthRequiredScopes,
+			Leeway:         config.Get().AuthTokenLeeway,
+		}, httpClient), nil
+	}
+
+	switch validatorType {
+	case "jwt":
+		return tokenDecoder, nil
+	case "introspection":
+		return introspection()
+	case "chain":
+		introspectionValidator, err := introspection()
+		if err != nil {
+			return nil, err
+		}
+		return auth.NewTokenValidatorChain(tokenDecoder, introspectionValidator), nil
+	default:
+		return nil, fmt.Errorf("unknown token validator %q", validatorType)
+	}
+}
+
 // getHTTPClient returns the HTTP Client instance used in the API.
 func getHTTPClient() *http.Client {
 	// extracted from https://github.com/hashicorp/go-cleanhttp/blob/master/cleanhttp.go
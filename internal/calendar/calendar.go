@@ -0,0 +1,237 @@
+// Package calendar serves events as iCalendar feeds that can be subscribed to in calendar apps.
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sebastianrosch/couchconnections/internal/ical"
+	"github.com/sebastianrosch/couchconnections/internal/store"
+)
+
+const (
+	// ProductID identifies CouchConnections as the product that created a calendar.
+	ProductID = "-//CouchConnections//Events//EN"
+	uidDomain = "couchconnections"
+
+	// seriesHorizon is how far series are expanded in the feed of upcoming events.
+	seriesHorizon = 365 * 24 * time.Hour
+)
+
+// FeedPath returns the path of the personal calendar feed for the given feed token.
+func FeedPath(token string) string {
+	return "/api/v1/feeds/" + token + ".ics"
+}
+
+// Authenticator interface
+type Authenticator interface {
+	// Authenticate authenticates a request
+	Authenticate(ctx context.Context) (context.Context, error)
+}
+
+// Authorizer interface
+type Authorizer interface {
+	// AssertCapabilityReaderOrCapabilityAdmin asserts that the context has at least read permissions for the capability
+	AssertCapabilityReaderOrCapabilityAdmin(ctx context.Context, environment string) error
+}
+
+// Handler serves single events, the feed of upcoming events and the personal feeds of users.
+// Single events and the upcoming events require the same access token or API key and read permission
+// as the API. Calendar apps can't send bearer tokens, so personal feeds are authorized by a secret feed
+// token in the path instead. The feeds never contain join links.
+type Handler struct {
+	logger        logr.Logger
+	store         store.Store
+	authenticator Authenticator
+	authorizer    Authorizer
+	now           func() time.Time
+}
+
+// NewHandler returns a new Handler that reads events from the given store.
+func NewHandler(logger logr.Logger, store store.Store, authenticator Authenticator, authorizer Authorizer) *Handler {
+	return &Handler{
+		logger:        logger,
+		store:         store,
+		authenticator: authenticator,
+		authorizer:    authorizer,
+		now:           time.Now,
+	}
+}
+
+// Register adds the calendar routes to the router.
+func (h *Handler) Register(router *mux.Router) {
+	router.HandleFunc("/api/v1/events.ics", h.authorized(h.serveUpcomingEvents)).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/events/{id}.ics", h.authorized(h.serveEvent)).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/feeds/{token}.ics", h.serveFeed).Methods(http.MethodGet)
+}
+
+// authorized wraps a handler so that it is only invoked for authenticated callers with read permission.
+// The authorization and API key headers are passed to the authenticator as request metadata, like the
+// gateway does for the API.
+func (h *Handler) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		md := metadata.MD{}
+		for _, header := range []string{"Authorization", "X-Api-Key"} {
+			if value := r.Header.Get(header); value != "" {
+				md.Set(header, value)
+			}
+		}
+
+		ctx, err := h.authenticator.Authenticate(metadata.NewIncomingContext(r.Context(), md))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		if err := h.authorizer.AssertCapabilityReaderOrCapabilityAdmin(ctx, ""); err != nil {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// serveEvent serves a single event.
+func (h *Handler) serveEvent(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	event, err := h.store.GetEventByID(id)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if !event.IsPublished() {
+		h.writeError(w, store.NewNotFoundError("event", id))
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", event.ID+".ics"))
+	h.writeCalendar(w, &ical.Calendar{
+		ProductID: ProductID,
+		Events:    []ical.Event{ToICalEvent(event)},
+	})
+}
+
+// serveUpcomingEvents serves all published events that have not ended yet and the occurrences of series within the next year.
+func (h *Handler) serveUpcomingEvents(w http.ResponseWriter, r *http.Request) {
+	now := h.now()
+	events, err := h.store.ListEvents(&store.EventQuery{From: now, Published: true})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	occurrences, err := store.GetOccurrences(h.store, now, now.Add(seriesHorizon))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	events = append(events, occurrences...)
+
+	calendar := &ical.Calendar{ProductID: ProductID, Name: "CouchConnections"}
+	for i := range events {
+		if !events[i].IsPublished() || !events[i].End.After(now) {
+			continue
+		}
+		calendar.Events = append(calendar.Events, ToICalEvent(&events[i]))
+	}
+
+	h.writeCalendar(w, calendar)
+}
+
+// serveFeed serves all published events the owner of the feed token has a confirmed registration for.
+func (h *Handler) serveFeed(w http.ResponseWriter, r *http.Request) {
+	token, err := h.store.GetFeedTokenByHash(store.HashToken(mux.Vars(r)["token"]))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	registrations, err := h.store.ListRegistrationsByUser(token.UserID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	calendar := &ical.Calendar{ProductID: ProductID, Name: "My CouchConnections"}
+	for _, registration := range registrations {
+		if registration.Status != store.RegistrationStatusConfirmed {
+			continue
+		}
+		event, err := h.store.GetEventByID(registration.EventID)
+		if store.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		if !event.IsPublished() {
+			continue
+		}
+		calendar.Events = append(calendar.Events, ToICalEvent(event))
+	}
+
+	h.writeCalendar(w, calendar)
+}
+
+// writeCalendar writes the encoded calendar to the response.
+func (h *Handler) writeCalendar(w http.ResponseWriter, calendar *ical.Calendar) {
+	w.Header().Set("Content-Type", ical.ContentType)
+	if err := calendar.Encode(w, h.now()); err != nil {
+		h.logger.Error(err, "failed to write calendar")
+	}
+}
+
+// writeError writes a not found or an internal server error to the response.
+func (h *Handler) writeError(w http.ResponseWriter, err error) {
+	if store.IsNotFound(err) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	h.logger.Error(err, "failed to serve calendar")
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+var statusToICal = map[store.EventStatus]ical.Status{
+	store.EventStatusDraft:     ical.StatusTentative,
+	store.EventStatusScheduled: ical.StatusConfirmed,
+	store.EventStatusLive:      ical.StatusConfirmed,
+	store.EventStatusFinished:  ical.StatusConfirmed,
+	store.EventStatusCancelled: ical.StatusCancelled,
+}
+
+// ToICalEvent converts a store event into an iCalendar event.
+// The join link is never set, callers that may show it set the URL themselves.
+func ToICalEvent(event *store.Event) ical.Event {
+	location, err := time.LoadLocation(event.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	description := event.Description
+	if event.Host != "" {
+		host := "Hosted by " + event.Host
+		if description != "" {
+			host += "\n\n"
+		}
+		description = host + description
+	}
+
+	return ical.Event{
+		UID:         event.ID + "@" + uidDomain,
+		Summary:     event.Topic,
+		Description: description,
+		Language:    event.Language,
+		Start:       event.Start,
+		End:         event.End,
+		Location:    location,
+		Status:      statusToICal[event.Status],
+	}
+}
@@ -0,0 +1,31 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+)
+
+func TestCalendar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Calendar Suite")
+}
+
+// staticAuthenticator authenticates the tokens in the authorization header with the permissions mapped to them.
+type staticAuthenticator map[string][]string
+
+func (a staticAuthenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if permissions, ok := a[value]; ok {
+			return auth.WithAuthorizationPermissions(ctx, permissions), nil
+		}
+	}
+	return nil, errors.New("invalid auth token")
+}
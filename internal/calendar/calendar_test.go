@@ -0,0 +1,156 @@
+package calendar
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	logrtesting "github.com/go-logr/logr/testing"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+)
+
+var _ = Describe("Calendar handler", func() {
+	var memoryStore *store.MemoryStore
+	var router *mux.Router
+	var past, upcoming, draft *store.Event
+
+	BeforeEach(func() {
+		memoryStore = store.NewMemoryStore()
+		authenticator := staticAuthenticator{
+			"Bearer reader":   {"capability:couchconnections:read"},
+			"Bearer stranger": {},
+		}
+		authorizer, err := auth.NewAuthorizer("couchconnections", nil)
+		Expect(err).ToNot(HaveOccurred())
+		handler := NewHandler(logrtesting.NullLogger{}, memoryStore, authenticator, authorizer)
+		handler.now = func() time.Time { return time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC) }
+		router = mux.NewRouter()
+		handler.Register(router)
+
+		past, _ = memoryStore.CreateEvent(&store.Event{
+			Topic:    "Remote work",
+			Start:    time.Date(2020, 3, 1, 18, 0, 0, 0, time.UTC),
+			End:      time.Date(2020, 3, 1, 19, 0, 0, 0, time.UTC),
+			TimeZone: "UTC",
+			Status:   store.EventStatusFinished,
+		})
+		upcoming, _ = memoryStore.CreateEvent(&store.Event{
+			Topic:    "How viruses spread",
+			ZoomLink: "https://zoom.us/j/123456789",
+			Start:    time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC),
+			End:      time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC),
+			TimeZone: "Europe/Berlin",
+			Status:   store.EventStatusScheduled,
+		})
+		draft, _ = memoryStore.CreateEvent(&store.Event{
+			Topic:    "Secret plans",
+			Start:    time.Date(2020, 5, 1, 18, 0, 0, 0, time.UTC),
+			End:      time.Date(2020, 5, 1, 19, 0, 0, 0, time.UTC),
+			TimeZone: "UTC",
+			Status:   store.EventStatusDraft,
+		})
+	})
+
+	getWithToken := func(path, token string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	get := func(path string) *httptest.ResponseRecorder {
+		return getWithToken(path, "reader")
+	}
+
+	DescribeTable("when events are requested without read permission",
+		func(path func() string, token string, code int) {
+			Expect(getWithToken(path(), token).Code).To(Equal(code))
+		},
+		Entry("a single event without a token", func() string { return "/api/v1/events/" + upcoming.ID + ".ics" }, "", http.StatusUnauthorized),
+		Entry("a single event with an invalid token", func() string { return "/api/v1/events/" + upcoming.ID + ".ics" }, "invalid", http.StatusUnauthorized),
+		Entry("a single event without permissions", func() string { return "/api/v1/events/" + upcoming.ID + ".ics" }, "stranger", http.StatusForbidden),
+		Entry("the upcoming events without a token", func() string { return "/api/v1/events.ics" }, "", http.StatusUnauthorized),
+		Entry("the upcoming events without permissions", func() string { return "/api/v1/events.ics" }, "stranger", http.StatusForbidden),
+	)
+
+	Describe("when a single event is requested", func() {
+		It("should return the event with its time zone", func() {
+			resp := get("/api/v1/events/" + upcoming.ID + ".ics")
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("text/calendar; charset=utf-8"))
+			Expect(resp.Body.String()).To(ContainSubstring("UID:" + upcoming.ID + "@couchconnections\r\n"))
+			Expect(resp.Body.String()).To(ContainSubstring("DTSTART;TZID=Europe/Berlin:20200401T200000\r\n"))
+			Expect(resp.Body.String()).To(ContainSubstring("TZID:Europe/Berlin\r\n"))
+			Expect(resp.Body.String()).ToNot(ContainSubstring("zoom.us"))
+		})
+
+		It("should not return drafts", func() {
+			resp := get("/api/v1/events/" + draft.ID + ".ics")
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("when the upcoming events are requested", func() {
+		It("should only return events that have not ended", func() {
+			resp := get("/api/v1/events.ics")
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.String()).To(ContainSubstring("SUMMARY:How viruses spread"))
+			Expect(resp.Body.String()).ToNot(ContainSubstring("SUMMARY:Remote work"))
+			Expect(resp.Body.String()).ToNot(ContainSubstring("SUMMARY:Secret plans"))
+		})
+	})
+
+	Describe("when a personal feed is requested", func() {
+		BeforeEach(func() {
+			memoryStore.RegisterForEvent(&store.Registration{EventID: past.ID, UserID: "alice"}, 0)
+			memoryStore.SaveFeedToken(&store.FeedToken{UserID: "alice", TokenHash: store.HashToken("secret")})
+		})
+
+		It("should return the events the user registered for", func() {
+			resp := getWithToken(FeedPath("secret"), "")
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.String()).To(ContainSubstring("SUMMARY:Remote work"))
+			Expect(resp.Body.String()).ToNot(ContainSubstring("SUMMARY:How viruses spread"))
+		})
+
+		It("should not return waitlisted registrations and unpublished events", func() {
+			full, _ := memoryStore.CreateEvent(&store.Event{
+				Topic:    "Fully booked",
+				Start:    time.Date(2020, 4, 2, 18, 0, 0, 0, time.UTC),
+				End:      time.Date(2020, 4, 2, 19, 0, 0, 0, time.UTC),
+				TimeZone: "UTC",
+				Status:   store.EventStatusScheduled,
+			})
+			memoryStore.RegisterForEvent(&store.Registration{EventID: full.ID, UserID: "bob"}, 1)
+			memoryStore.RegisterForEvent(&store.Registration{EventID: full.ID, UserID: "alice"}, 1)
+			memoryStore.RegisterForEvent(&store.Registration{EventID: draft.ID, UserID: "alice"}, 0)
+
+			resp := get(FeedPath("secret"))
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.String()).To(ContainSubstring("SUMMARY:Remote work"))
+			Expect(resp.Body.String()).ToNot(ContainSubstring("SUMMARY:Fully booked"))
+			Expect(resp.Body.String()).ToNot(ContainSubstring("SUMMARY:Secret plans"))
+		})
+
+		It("should reject unknown and revoked tokens", func() {
+			Expect(get(FeedPath("unknown")).Code).To(Equal(http.StatusNotFound))
+
+			Expect(memoryStore.DeleteFeedToken("alice")).To(Succeed())
+			Expect(get(FeedPath("secret")).Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
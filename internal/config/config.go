@@ -3,6 +3,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
@@ -23,6 +25,12 @@ type Settings struct {
 	GRPCPort        string `envconfig:"GRPC_PORT" default:"8924"`
 	HealthCheckPort string `envconfig:"HEALTHCHECK_PORT" default:"8925"`
 
+	// StoreType sets the store implementation. Valid values are "mongo" or "memory" (Default: "mongo").
+	StoreType string `envconfig:"STORE_TYPE" default:"mongo"`
+
+	// JoinLinkWindow sets how long before the start of an event confirmed attendees can see its join link (Default: 15m).
+	JoinLinkWindow time.Duration `envconfig:"JOIN_LINK_WINDOW" default:"15m"`
+
 	DatabaseURI      string `envconfig:"MONGO_URI" default:"mongodb://localhost:27017"`
 	DatabaseName     string `envconfig:"MONGO_DATABASE_NAME" default:"couchconnections"`
 	DatabaseUsername string `envconfig:"MONGO_USERNAME"`
@@ -33,8 +41,37 @@ type Settings struct {
 	Auth0CallbackURL  string `envconfig:"AUTH0_CALLBACK_URL"`
 	Auth0Domain       string `envconfig:"AUTH0_DOMAIN"`
 
+	// AuthCapability sets the capability of the service in permissions, e.g. "capability:couchconnections:admin" (Default: "couchconnections").
+	AuthCapability string `envconfig:"AUTH_CAPABILITY" default:"couchconnections"`
+
 	AuthJwksURL          string `envconfig:"AUTH_JWKS_CONFIG" default:"https://livingroompresentation.eu.auth0.com/.well-known/jwks.json"`
 	AuthUserInfoEndpoint string `envconfig:"AUTH_USER_INFO_ENDPOINT" default:"https://livingroompresentation.eu.auth0.com/userinfo"`
+
+	// AuthJwksDefaultTTL sets how long signing keys are cached if the JWKS response has no Cache-Control max-age (Default: 10m).
+	AuthJwksDefaultTTL time.Duration `envconfig:"AUTH_JWKS_DEFAULT_TTL" default:"10m"`
+	// AuthJwksMinRefetchInterval limits how often tokens with an unknown key ID can cause the JWKS to be fetched (Default: 30s).
+	AuthJwksMinRefetchInterval time.Duration `envconfig:"AUTH_JWKS_MIN_REFETCH_INTERVAL" default:"30s"`
+	// AuthJwtAlgorithms sets the signing algorithms accepted in access tokens, e.g. "RS256,ES256,EdDSA" (Default: "RS256,RS384,RS512").
+	AuthJwtAlgorithms []string `envconfig:"AUTH_JWT_ALGORITHMS" default:"RS256,RS384,RS512"`
+	// AuthIssuers sets the accepted issuers of access tokens. If empty, every issuer is accepted.
+	AuthIssuers []string `envconfig:"AUTH_ISSUERS" default:"https://livingroompresentation.eu.auth0.com/"`
+	// AuthAudiences sets the accepted audiences of access tokens. If empty, every audience is accepted.
+	AuthAudiences []string `envconfig:"AUTH_AUDIENCES"`
+	// AuthRequiredScopes sets the scopes that every access token must grant.
+	AuthRequiredScopes []string `envconfig:"AUTH_REQUIRED_SCOPES"`
+	// AuthTokenLeeway sets the clock skew allowed when the expiry of access tokens is validated (Default: 1m).
+	AuthTokenLeeway time.Duration `envconfig:"AUTH_TOKEN_LEEWAY" default:"1m"`
+	// AuthTokenValidator sets how access tokens are validated. Valid values are "jwt" (locally with the JWKS),
+	// "introspection" (with the introspection endpoint) or "chain" (JWTs locally, other tokens with the introspection endpoint) (Default: "jwt").
+	AuthTokenValidator string `envconfig:"AUTH_TOKEN_VALIDATOR" default:"jwt"`
+	// AuthIntrospectionEndpoint sets the RFC 7662 token introspection endpoint of the authorization server.
+	AuthIntrospectionEndpoint     string `envconfig:"AUTH_INTROSPECTION_ENDPOINT"`
+	AuthIntrospectionClientID     string `envconfig:"AUTH_INTROSPECTION_CLIENT_ID"`
+	AuthIntrospectionClientSecret string `envconfig:"AUTH_INTROSPECTION_CLIENT_SECRET"`
+	// AuthUserInfoCacheSize sets how many tokens the user info is cached for (Default: 10000).
+	AuthUserInfoCacheSize int `envconfig:"AUTH_USER_INFO_CACHE_SIZE" default:"10000"`
+	// AuthUserInfoCacheTTL sets how long the user info of a token is cached, at most until the token expires (Default: 5m).
+	AuthUserInfoCacheTTL time.Duration `envconfig:"AUTH_USER_INFO_CACHE_TTL" default:"5m"`
 }
 
 // Init parses configuration from the environment. This should be called only once per application startup (typically in Main)
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/options"
+)
+
+// missingPermission is the reason of the error details returned for each permission that a caller is missing.
+const missingPermission = "MISSING_PERMISSION"
+
+// Authorizer interface
+type Authorizer interface {
+	// AssertCapabilityReaderOrCapabilityAdmin asserts that the context has at least read permissions for the capability
+	AssertCapabilityReaderOrCapabilityAdmin(ctx context.Context, environment string) error
+	// AssertCapabilityWriterOrCapabilityAdmin asserts that the context has at least write permissions for the capability
+	AssertCapabilityWriterOrCapabilityAdmin(ctx context.Context, environment string) error
+	// AssertCapabilityAdmin asserts that the context has admin permissions for the capability
+	AssertCapabilityAdmin(ctx context.Context) error
+}
+
+// MethodPermissions maps the full names of gRPC methods to the permission that is required to invoke them.
+type MethodPermissions map[string]options.Permission
+
+// GetMethodPermissions returns the permissions declared with the (couchconnections.required_permission) option
+// on the methods of all services in the proto file that declares the message.
+func GetMethodPermissions(message descriptor.Message) (MethodPermissions, error) {
+	file, _ := descriptor.ForMessage(message)
+
+	permissions := MethodPermissions{}
+	for _, service := range file.GetService() {
+		for _, method := range service.GetMethod() {
+			if method.GetOptions() == nil || !proto.HasExtension(method.GetOptions(), options.E_RequiredPermission) {
+				continue
+			}
+			extension, err := proto.GetExtension(method.GetOptions(), options.E_RequiredPermission)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't read the required permission of %s.%s: %v", service.GetName(), method.GetName(), err)
+			}
+			fullName := fmt.Sprintf("/%s.%s/%s", file.GetPackage(), service.GetName(), method.GetName())
+			permissions[fullName] = *extension.(*options.Permission)
+		}
+	}
+
+	return permissions, nil
+}
+
+// authorizerAsUnaryInterceptor asserts that the caller has the permission required by the method and
+// returns a gRPC PermissionDenied error with the missing permissions as details otherwise.
+// Methods without a required permission can be invoked by every authenticated caller.
+func authorizerAsUnaryInterceptor(authorizer Authorizer, permissions MethodPermissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if err := assertPermission(ctx, authorizer, permissions[info.FullMethod]); err != nil {
+			return nil, permissionDenied(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// assertPermission asserts that the context has the permission.
+func assertPermission(ctx context.Context, authorizer Authorizer, permission options.Permission) error {
+	switch permission {
+	case options.Permission_PERMISSION_READ:
+		return authorizer.AssertCapabilityReaderOrCapabilityAdmin(ctx, "")
+	case options.Permission_PERMISSION_WRITE:
+		return authorizer.AssertCapabilityWriterOrCapabilityAdmin(ctx, "")
+	case options.Permission_PERMISSION_ADMIN:
+		return authorizer.AssertCapabilityAdmin(ctx)
+	}
+
+	return nil
+}
+
+// permissionDenied converts an authorization error to a PermissionDenied error
+// with an ErrorInfo detail for each missing permission.
+func permissionDenied(err error) error {
+	st := status.New(codes.PermissionDenied, err.Error())
+
+	aerr, ok := err.(interface{ GetMissingPermissions() []string })
+	if !ok {
+		return st.Err()
+	}
+
+	details := make([]proto.Message, 0, len(aerr.GetMissingPermissions()))
+	for _, permission := range aerr.GetMissingPermissions() {
+		details = append(details, &errdetails.ErrorInfo{
+			Reason:   missingPermission,
+			Metadata: map[string]string{"permission": permission},
+		})
+	}
+	withDetails, derr := st.WithDetails(details...)
+	if derr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
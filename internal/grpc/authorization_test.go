@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	"github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/options"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Authorization", func() {
+	var interceptor grpc.UnaryServerInterceptor
+	var called bool
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	invoke := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	BeforeEach(func() {
+		authorizer, err := auth.NewAuthorizer("couchconnections", nil)
+		Expect(err).ToNot(HaveOccurred())
+		permissions, err := GetMethodPermissions(&v1.Version{})
+		Expect(err).ToNot(HaveOccurred())
+
+		interceptor = authorizerAsUnaryInterceptor(authorizer, permissions)
+		called = false
+	})
+
+	Describe("when the method permissions are read from the proto file", func() {
+		It("should return the declared permissions", func() {
+			permissions, err := GetMethodPermissions(&v1.Version{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(permissions).To(HaveKeyWithValue("/v1.CouchConnections/GetEvent", options.Permission_PERMISSION_READ))
+			Expect(permissions).To(HaveKeyWithValue("/v1.CouchConnections/CreateEvent", options.Permission_PERMISSION_WRITE))
+			Expect(permissions).To(HaveKeyWithValue("/v1.CouchConnections/ApproveEvent", options.Permission_PERMISSION_ADMIN))
+			Expect(permissions).ToNot(HaveKey("/v1.CouchConnections/GetVersion"))
+		})
+	})
+
+	Describe("when the caller has the required permission", func() {
+		It("should invoke the method", func() {
+			ctx := auth.WithAuthorizationPermissions(context.Background(), []string{"capability:couchconnections:write"})
+
+			err := invoke(ctx, "/v1.CouchConnections/CreateEvent")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeTrue())
+		})
+
+		It("should accept a higher permission", func() {
+			ctx := auth.WithAuthorizationPermissions(context.Background(), []string{"capability:couchconnections:admin"})
+
+			err := invoke(ctx, "/v1.CouchConnections/GetEvent")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	Describe("when the caller is missing the required permission", func() {
+		It("should deny the request with the missing permission as details", func() {
+			ctx := auth.WithAuthorizationPermissions(context.Background(), []string{"capability:couchconnections:read"})
+
+			err := invoke(ctx, "/v1.CouchConnections/CreateEvent")
+
+			Expect(called).To(BeFalse())
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			details := status.Convert(err).Details()
+			Expect(details).To(HaveLen(1))
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			Expect(ok).To(BeTrue())
+			Expect(info.GetReason()).To(Equal(missingPermission))
+			Expect(info.GetMetadata()).To(HaveKeyWithValue("permission", "capability:couchconnections:write"))
+		})
+	})
+
+	Describe("when the method has no required permission", func() {
+		It("should invoke the method", func() {
+			err := invoke(context.Background(), "/v1.CouchConnections/GetVersion")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeTrue())
+		})
+	})
+})
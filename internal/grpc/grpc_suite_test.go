@@ -0,0 +1,13 @@
+package grpc
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGRPC(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "gRPC Suite")
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/sebastianrosch/couchconnections/internal/service"
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
 	"github.com/twitchtv/twirp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -13,6 +15,7 @@ import (
 	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 )
 
 // Authenticator interface
@@ -26,16 +29,29 @@ func GetServer(
 	ctx context.Context,
 	logger logr.Logger,
 	v1Service v1.CouchConnectionsServer,
-	authenticator Authenticator) *grpc.Server {
+	authenticator Authenticator,
+	authorizer Authorizer) (*grpc.Server, error) {
 	authenticatorMiddleware := authenticatorAsUnaryInterceptor(authenticator)
 
+	permissions, err := GetMethodPermissions(&v1.Version{})
+	if err != nil {
+		return nil, err
+	}
+	authorizerMiddleware := authorizerAsUnaryInterceptor(authorizer, permissions)
+
 	// Register the gRPC server.
-	middlewares := grpc_middleware.ChainUnaryServer(extractMethodInfoMiddleware, authenticatorMiddleware, convertTwirpError)
+	middlewares := grpc_middleware.ChainUnaryServer(
+		extractMethodInfoMiddleware,
+		authenticatorMiddleware,
+		authorizerMiddleware,
+		grpc_validator.UnaryServerInterceptor(),
+		convertTwirpError,
+		convertStoreError)
 	server := grpc.NewServer(grpc.UnaryInterceptor(middlewares))
 	v1.RegisterCouchConnectionsServer(server, v1Service)
 
 	// Return the gRPC server.
-	return server
+	return server, nil
 }
 
 // convertTwirpError converts the internal Twirp error to a gRPC error code, if one occurred.
@@ -87,6 +103,20 @@ func convertTwirpError(ctx context.Context,
 	return resp, err
 }
 
+// convertStoreError converts store errors to a gRPC error code, if one occurred.
+func convertStoreError(ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+
+	if err != nil && store.IsNotFound(err) {
+		return resp, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
 // extractMethodInfoMiddleware extracts the full method name and it stores into the context
 func extractMethodInfoMiddleware(ctx context.Context,
 	req interface{},
@@ -100,12 +130,16 @@ func extractMethodInfoMiddleware(ctx context.Context,
 }
 
 // authenticatorAsUnaryInterceptor calls the Authenticate function and wraps the error as a grpc Unauthenticated error
+// Tokens without the required scopes are authenticated, but not authorized, so they are rejected as PermissionDenied.
 func authenticatorAsUnaryInterceptor(authenticator Authenticator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (interface{}, error) {
 		ctx, err := authenticator.Authenticate(ctx)
+		if _, ok := err.(*auth.MissingScopesError); ok {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, err.Error())
 		}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+)
+
+var _ = Describe("gRPC server", func() {
+	var info *grpc.UnaryServerInfo
+
+	BeforeEach(func() {
+		info = &grpc.UnaryServerInfo{FullMethod: "/v1.CouchConnections/GetEvent"}
+	})
+
+	Describe("when the handler returns a store not found error", func() {
+		It("should convert it to a NotFound status", func() {
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, store.NewNotFoundError("event", "123")
+			}
+
+			_, err := convertStoreError(context.Background(), nil, info, handler)
+
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(status.Convert(err).Message()).To(Equal("event 123 not found"))
+		})
+	})
+
+	Describe("when the handler returns any other error", func() {
+		It("should return the error unchanged", func() {
+			expected := errors.New("something went wrong")
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, expected
+			}
+
+			_, err := convertStoreError(context.Background(), nil, info, handler)
+
+			Expect(err).To(Equal(expected))
+		})
+	})
+})
@@ -0,0 +1,243 @@
+// Package ical provides an encoder for iCalendar objects as defined in RFC 5545.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// ContentType is the media type of iCalendar objects.
+	ContentType = "text/calendar; charset=utf-8"
+
+	// maxLineLength is the maximum length of a content line in octets, excluding the line break.
+	maxLineLength = 75
+
+	dateTimeFormat    = "20060102T150405"
+	utcDateTimeFormat = "20060102T150405Z"
+)
+
+// Status is the status of an event.
+type Status string
+
+const (
+	// StatusTentative is the status of an event that is not yet confirmed.
+	StatusTentative Status = "TENTATIVE"
+	// StatusConfirmed is the status of a confirmed event.
+	StatusConfirmed Status = "CONFIRMED"
+	// StatusCancelled is the status of a cancelled event.
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Calendar is an iCalendar object containing a list of events.
+type Calendar struct {
+	// ProductID identifies the product that created the calendar.
+	ProductID string
+	// Name is the display name of the calendar shown by calendar apps.
+	Name string
+	// Events are the events in the calendar.
+	Events []Event
+}
+
+// Event is a single event in a calendar.
+type Event struct {
+	// UID is the globally unique identifier of the event.
+	UID string
+	// Summary is the title of the event.
+	Summary string
+	// Description is the description of the event.
+	Description string
+	// Language is the language of the summary and description as BCP 47 language tag.
+	Language string
+	// Start is the start time of the event.
+	Start time.Time
+	// End is the end time of the event.
+	End time.Time
+	// Location is the time zone the event is presented in. UTC is used if it is nil.
+	Location *time.Location
+	// Status is the status of the event.
+	Status Status
+	// URL is a link to join or learn more about the event.
+	URL string
+	// LastModified is the time the event was last changed.
+	LastModified time.Time
+}
+
+// Encode writes the calendar to w. The time stamp of all events is set to now.
+func (c *Calendar) Encode(w io.Writer, now time.Time) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", c.ProductID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, tz := range c.timeZones() {
+		encodeTimeZone(e, tz.location, tz.from, tz.to)
+	}
+	for i := range c.Events {
+		encodeEvent(e, &c.Events[i], now)
+	}
+
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// timeZoneRange is a time zone used by events in the calendar and the time range the events cover.
+type timeZoneRange struct {
+	location *time.Location
+	from     time.Time
+	to       time.Time
+}
+
+// timeZones returns the time zones used by the events, except UTC, in a stable order.
+func (c *Calendar) timeZones() []timeZoneRange {
+	ranges := map[string]*timeZoneRange{}
+	for _, event := range c.Events {
+		if isUTC(event.Location) {
+			continue
+		}
+
+		name := event.Location.String()
+		r, ok := ranges[name]
+		if !ok {
+			r = &timeZoneRange{location: event.Location, from: event.Start, to: event.End}
+			ranges[name] = r
+		}
+		if event.Start.Before(r.from) {
+			r.from = event.Start
+		}
+		if event.End.After(r.to) {
+			r.to = event.End
+		}
+	}
+
+	results := make([]timeZoneRange, 0, len(ranges))
+	for _, r := range ranges {
+		results = append(results, *r)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].location.String() < results[j].location.String()
+	})
+
+	return results
+}
+
+// encodeEvent writes a VEVENT component.
+func encodeEvent(e *encoder, event *Event, now time.Time) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", escapeText(event.UID))
+	e.line("DTSTAMP", now.UTC().Format(utcDateTimeFormat))
+	e.dateTime("DTSTART", event.Start, event.Location)
+	e.dateTime("DTEND", event.End, event.Location)
+	e.text("SUMMARY", event.Summary, event.Language)
+	if event.Description != "" {
+		e.text("DESCRIPTION", event.Description, event.Language)
+	}
+	if event.Status != "" {
+		e.line("STATUS", string(event.Status))
+	}
+	if event.URL != "" {
+		e.line("URL", event.URL)
+	}
+	if !event.LastModified.IsZero() {
+		e.line("LAST-MODIFIED", event.LastModified.UTC().Format(utcDateTimeFormat))
+	}
+	e.line("END", "VEVENT")
+}
+
+// encoder writes content lines and keeps the first error that occurred.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes a content line with the given name and an already escaped value.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.WriteString(fold(name + ":" + value))
+}
+
+// text writes a content line with a text value and an optional language parameter.
+func (e *encoder) text(name, value, language string) {
+	if language != "" {
+		name += ";LANGUAGE=" + language
+	}
+	e.line(name, escapeText(value))
+}
+
+// dateTime writes a content line with a date-time value in UTC or the local time of the given location.
+func (e *encoder) dateTime(name string, t time.Time, location *time.Location) {
+	if isUTC(location) {
+		e.line(name, t.UTC().Format(utcDateTimeFormat))
+		return
+	}
+	e.line(name+";TZID="+location.String(), t.In(location).Format(dateTimeFormat))
+}
+
+// isUTC returns true if the location is nil or UTC.
+func isUTC(location *time.Location) bool {
+	return location == nil || location == time.UTC || location.String() == "UTC"
+}
+
+// escapeText escapes a TEXT value as defined in RFC 5545 section 3.3.11.
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// fold splits a content line into lines of at most 75 octets as defined in RFC 5545 section 3.1.
+// Lines are only split between UTF-8 characters and are terminated by CRLF.
+func fold(line string) string {
+	var b strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > maxLineLength {
+			b.WriteString("\r\n ")
+			// The leading space of a continuation line counts towards its length.
+			length = 1
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	b.WriteString("\r\n")
+
+	return b.String()
+}
+
+// formatOffset formats a UTC offset in seconds as defined in RFC 5545 section 3.3.14.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	hours, minutes, seconds := offset/3600, offset%3600/60, offset%60
+	if seconds != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, hours, minutes)
+}
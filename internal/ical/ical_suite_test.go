@@ -0,0 +1,13 @@
+package ical
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestICal(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "iCalendar Suite")
+}
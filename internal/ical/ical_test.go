@@ -0,0 +1,128 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("iCalendar encoder", func() {
+	var now time.Time
+	var berlin *time.Location
+
+	BeforeEach(func() {
+		now = time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+		berlin, _ = time.LoadLocation("Europe/Berlin")
+	})
+
+	encode := func(calendar *Calendar) string {
+		var buf bytes.Buffer
+		Expect(calendar.Encode(&buf, now)).To(Succeed())
+		return buf.String()
+	}
+
+	Describe("when a calendar is encoded", func() {
+		It("should write an event in UTC", func() {
+			output := encode(&Calendar{
+				ProductID: "-//Test//EN",
+				Events: []Event{{
+					UID:     "1@test",
+					Summary: "How viruses spread",
+					Start:   time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC),
+					End:     time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC),
+					Status:  StatusConfirmed,
+				}},
+			})
+
+			Expect(output).To(Equal(strings.Join([]string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:-//Test//EN",
+				"CALSCALE:GREGORIAN",
+				"METHOD:PUBLISH",
+				"BEGIN:VEVENT",
+				"UID:1@test",
+				"DTSTAMP:20200301T120000Z",
+				"DTSTART:20200401T180000Z",
+				"DTEND:20200401T190000Z",
+				"SUMMARY:How viruses spread",
+				"STATUS:CONFIRMED",
+				"END:VEVENT",
+				"END:VCALENDAR",
+				"",
+			}, "\r\n")))
+		})
+
+		It("should escape text values", func() {
+			output := encode(&Calendar{Events: []Event{{
+				Summary:     "Viruses, bacteria; and more",
+				Description: "First line\nC:\\temp",
+				Language:    "en",
+			}}})
+
+			Expect(output).To(ContainSubstring(`SUMMARY;LANGUAGE=en:Viruses\, bacteria\; and more` + "\r\n"))
+			Expect(output).To(ContainSubstring(`DESCRIPTION;LANGUAGE=en:First line\nC:\\temp` + "\r\n"))
+		})
+
+		It("should fold long lines without splitting characters", func() {
+			output := encode(&Calendar{Events: []Event{{
+				Description: strings.Repeat("ä", 100),
+			}}})
+
+			for _, line := range strings.Split(output, "\r\n") {
+				Expect(len(line)).To(BeNumerically("<=", 75))
+			}
+			Expect(output).To(ContainSubstring("\r\n ä"))
+		})
+	})
+
+	Describe("when an event uses a time zone", func() {
+		It("should write local times and the observances of the time zone", func() {
+			output := encode(&Calendar{Events: []Event{{
+				Start:    time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC),
+				End:      time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC),
+				Location: berlin,
+			}}})
+
+			Expect(output).To(ContainSubstring("DTSTART;TZID=Europe/Berlin:20200401T200000\r\n"))
+			Expect(output).To(ContainSubstring(strings.Join([]string{
+				"BEGIN:VTIMEZONE",
+				"TZID:Europe/Berlin",
+				"BEGIN:STANDARD",
+				"DTSTART:20200101T000000",
+				"TZOFFSETFROM:+0100",
+				"TZOFFSETTO:+0100",
+				"TZNAME:CET",
+				"END:STANDARD",
+				"BEGIN:DAYLIGHT",
+				"DTSTART:20200329T020000",
+				"TZOFFSETFROM:+0100",
+				"TZOFFSETTO:+0200",
+				"TZNAME:CEST",
+				"END:DAYLIGHT",
+				"BEGIN:STANDARD",
+				"DTSTART:20201025T030000",
+				"TZOFFSETFROM:+0200",
+				"TZOFFSETTO:+0100",
+				"TZNAME:CET",
+				"END:STANDARD",
+				"END:VTIMEZONE",
+			}, "\r\n")))
+		})
+
+		It("should start with daylight saving time in the southern hemisphere", func() {
+			sydney, _ := time.LoadLocation("Australia/Sydney")
+
+			output := encode(&Calendar{Events: []Event{{
+				Start:    time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC),
+				End:      time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC),
+				Location: sydney,
+			}}})
+
+			Expect(output).To(ContainSubstring("TZID:Australia/Sydney\r\nBEGIN:DAYLIGHT\r\nDTSTART:20200101T000000\r\nTZOFFSETFROM:+1100"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package ical
+
+import (
+	"time"
+)
+
+// transition is a change of the UTC offset of a time zone.
+type transition struct {
+	at         time.Time
+	offsetFrom int
+	offsetTo   int
+	name       string
+}
+
+// encodeTimeZone writes a VTIMEZONE component with all observances of the location
+// that are in effect in the years between from and to.
+//
+// Go does not expose the rules of a time zone, so the transitions are found by
+// probing the offset of the location and written as individual observances.
+func encodeTimeZone(e *encoder, location *time.Location, from, to time.Time) {
+	start := time.Date(from.In(location).Year(), time.January, 1, 0, 0, 0, 0, location)
+	end := time.Date(to.In(location).Year()+1, time.January, 1, 0, 0, 0, 0, location)
+	transitions := findTransitions(location, start, end)
+
+	e.line("BEGIN", "VTIMEZONE")
+	e.line("TZID", location.String())
+
+	// The first observance covers the time from the start of the range until the first transition.
+	name, offset := start.Zone()
+	initial := transition{at: start, offsetFrom: offset, offsetTo: offset, name: name}
+	daylight := len(transitions) > 0 && transitions[0].offsetTo < offset
+	encodeObservance(e, initial, daylight)
+
+	for _, t := range transitions {
+		encodeObservance(e, t, t.offsetTo > t.offsetFrom)
+	}
+
+	e.line("END", "VTIMEZONE")
+}
+
+// encodeObservance writes a STANDARD or DAYLIGHT component for a transition.
+func encodeObservance(e *encoder, t transition, daylight bool) {
+	component := "STANDARD"
+	if daylight {
+		component = "DAYLIGHT"
+	}
+
+	// The start of an observance is given in the local time before the transition.
+	local := t.at.In(time.FixedZone("", t.offsetFrom))
+
+	e.line("BEGIN", component)
+	e.line("DTSTART", local.Format(dateTimeFormat))
+	e.line("TZOFFSETFROM", formatOffset(t.offsetFrom))
+	e.line("TZOFFSETTO", formatOffset(t.offsetTo))
+	if t.name != "" {
+		e.line("TZNAME", escapeText(t.name))
+	}
+	e.line("END", component)
+}
+
+// findTransitions returns all changes of the UTC offset of the location between start and end.
+func findTransitions(location *time.Location, start, end time.Time) []transition {
+	var transitions []transition
+
+	// Offsets don't change more than once a day, so checking every 12 hours finds all transitions.
+	const step = 12 * time.Hour
+	previous := start.In(location)
+	for current := start.Add(step); !previous.After(end); current = current.Add(step) {
+		current = current.In(location)
+		_, previousOffset := previous.Zone()
+		if _, currentOffset := current.Zone(); currentOffset != previousOffset {
+			at := searchTransition(previous, current)
+			name, offset := at.Zone()
+			transitions = append(transitions, transition{
+				at:         at,
+				offsetFrom: previousOffset,
+				offsetTo:   offset,
+				name:       name,
+			})
+		}
+		previous = current
+	}
+
+	return transitions
+}
+
+// searchTransition returns the first second after low with the offset of high.
+func searchTransition(low, high time.Time) time.Time {
+	_, offset := high.Zone()
+	for high.Sub(low) > time.Second {
+		middle := low.Add(high.Sub(low) / 2).Truncate(time.Second)
+		if _, o := middle.Zone(); o == offset {
+			high = middle
+		} else {
+			low = middle
+		}
+	}
+
+	return high
+}
@@ -0,0 +1,13 @@
+package recurrence
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRecurrence(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Recurrence Suite")
+}
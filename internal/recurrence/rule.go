@@ -0,0 +1,452 @@
+// Package recurrence implements recurrence rules as defined in RFC 5545 section 3.3.10.
+//
+// The supported subset covers the rules used for event series: FREQ (DAILY, WEEKLY,
+// MONTHLY and YEARLY), INTERVAL, COUNT, UNTIL, BYDAY and BYMONTHDAY.
+package recurrence
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the interval at which a rule repeats.
+type Frequency string
+
+const (
+	// Daily repeats every day.
+	Daily Frequency = "DAILY"
+	// Weekly repeats every week.
+	Weekly Frequency = "WEEKLY"
+	// Monthly repeats every month.
+	Monthly Frequency = "MONTHLY"
+	// Yearly repeats every year.
+	Yearly Frequency = "YEARLY"
+)
+
+const untilFormat = "20060102T150405Z"
+
+// maxEmptyPeriods is the number of consecutive periods without an occurrence after which
+// the iteration stops, e.g. for a monthly rule on the fifth Monday that is rarely matched.
+const maxEmptyPeriods = 1000
+
+// maxYears is how many years after the start of a series its occurrences are iterated.
+// Later occurrences are ignored, so that a time far in the future can't make the iteration run for long.
+const maxYears = 100
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// Weekday is a day of the week in a BYDAY list.
+// For monthly rules, N selects the nth occurrence of the day in the month, counting from the end if negative.
+// Zero selects every occurrence.
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+// String returns the weekday as used in a BYDAY list, e.g. "WE" or "-1FR".
+func (w Weekday) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Day]
+	}
+	return strconv.Itoa(w.N) + weekdayNames[w.Day]
+}
+
+// Rule is a recurrence rule.
+type Rule struct {
+	Frequency  Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []Weekday
+	ByMonthDay []int
+}
+
+// Parse parses a recurrence rule such as "FREQ=WEEKLY;BYDAY=WE;COUNT=10".
+// An optional "RRULE:" prefix is ignored.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("recurrence rule is empty")
+	}
+
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		name, val := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Frequency = Frequency(val)
+			switch rule.Frequency {
+			case Daily, Weekly, Monthly, Yearly:
+			default:
+				return nil, fmt.Errorf("unsupported frequency %q", val)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(name, val)
+		case "COUNT":
+			rule.Count, err = parsePositive(name, val)
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseByMonthDay(val)
+		case "WKST":
+			if val != "MO" {
+				err = fmt.Errorf("only WKST=MO is supported")
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Frequency == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return nil, fmt.Errorf("COUNT and UNTIL must not be used together")
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Frequency != Monthly {
+		return nil, fmt.Errorf("BYMONTHDAY is only supported for monthly rules")
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Frequency != Monthly {
+			return nil, fmt.Errorf("numbered BYDAY values are only supported for monthly rules")
+		}
+	}
+
+	return rule, nil
+}
+
+// String returns the rule in the format accepted by Parse, without the "RRULE:" prefix.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilFormat))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, day := range r.ByMonthDay {
+			days[i] = strconv.Itoa(day)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Iterate calls fn with the occurrences of a series that starts at start in chronological order,
+// until fn returns false or the rule ends. The occurrences keep the wall clock time of start in
+// its location, so they don't shift when daylight saving time begins or ends.
+// The start is only an occurrence if it matches the rule. Occurrences more than 100 years after
+// the start are ignored.
+func (r *Rule) Iterate(start time.Time, fn func(time.Time) bool) {
+	r.iterate(start, 0, fn)
+}
+
+// iterate calls fn with the occurrences of the rule, starting with the given period.
+func (r *Rule) iterate(start time.Time, first int, fn func(time.Time) bool) {
+	horizon := start.AddDate(maxYears, 0, 0)
+	count := 0
+	empty := 0
+	for period := first; empty < maxEmptyPeriods; period++ {
+		candidates := r.candidates(start, period)
+		if len(candidates) == 0 {
+			empty++
+			continue
+		}
+		empty = 0
+
+		for _, t := range candidates {
+			if t.Before(start) {
+				continue
+			}
+			if t.After(horizon) || (!r.Until.IsZero() && t.After(r.Until)) {
+				return
+			}
+			if !fn(t) {
+				return
+			}
+			count++
+			if r.Count > 0 && count >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// Between returns the occurrences of a series that starts at start, which are at or after from and before to.
+func (r *Rule) Between(start, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+	r.iterate(start, r.periodBefore(start, from), func(t time.Time) bool {
+		if !t.Before(to) {
+			return false
+		}
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+		return true
+	})
+
+	return occurrences
+}
+
+// Includes returns true if t is an occurrence of a series that starts at start.
+func (r *Rule) Includes(start, t time.Time) bool {
+	if t.Before(start) || t.After(start.AddDate(maxYears, 0, 0)) {
+		return false
+	}
+
+	included := false
+	r.iterate(start, r.periodBefore(start, t), func(occurrence time.Time) bool {
+		if occurrence.Equal(t) {
+			included = true
+		}
+		return occurrence.Before(t)
+	})
+
+	return included
+}
+
+// periodBefore returns a period of the rule that starts before t, as close to t as possible,
+// so that the iteration doesn't need to start at the first period. Rules with COUNT are always
+// iterated from the first period, because the earlier occurrences count.
+func (r *Rule) periodBefore(start, t time.Time) int {
+	if r.Count > 0 || !t.After(start) || t.After(start.AddDate(maxYears, 0, 0)) {
+		return 0
+	}
+	t = t.In(start.Location())
+
+	var periods int
+	switch r.Frequency {
+	case Daily:
+		periods = daysBetween(start, t)
+	case Weekly:
+		// Weeks start on Monday.
+		periods = (daysBetween(start, t) + (int(start.Weekday())+6)%7) / 7
+	case Monthly:
+		periods = (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	case Yearly:
+		periods = t.Year() - start.Year()
+	}
+
+	// The period before the one that contains t, in case the wall clock time moved t into the next day.
+	period := periods/r.Interval - 1
+	if period < 0 {
+		return 0
+	}
+	return period
+}
+
+// daysBetween returns the number of calendar days from the date of a to the date of b.
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
+
+// candidates returns the sorted occurrence candidates of the given period, ignoring COUNT and UNTIL.
+func (r *Rule) candidates(start time.Time, period int) []time.Time {
+	hour, min, sec := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, min, sec, start.Nanosecond(), start.Location())
+	}
+	year, month, day := start.Date()
+	step := period * r.Interval
+
+	var candidates []time.Time
+	switch r.Frequency {
+	case Daily:
+		t := at(year, month, day+step)
+		if r.matchesWeekday(t.Weekday()) {
+			candidates = append(candidates, t)
+		}
+	case Weekly:
+		// Weeks start on Monday.
+		monday := day - (int(start.Weekday())+6)%7 + 7*step
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []Weekday{{Day: start.Weekday()}}
+		}
+		for _, weekday := range days {
+			candidates = append(candidates, at(year, month, monday+(int(weekday.Day)+6)%7))
+		}
+	case Monthly:
+		first := time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, start.Location())
+		for _, d := range r.monthDays(first, day) {
+			candidates = append(candidates, at(first.Year(), first.Month(), d))
+		}
+	case Yearly:
+		t := at(year+step, month, day)
+		// Skip years without the day, e.g. February 29 in non-leap years.
+		if t.Day() == day {
+			candidates = append(candidates, t)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+	return dedupe(candidates)
+}
+
+// monthDays returns the days of the month starting at first that match the rule.
+func (r *Rule) monthDays(first time.Time, startDay int) []int {
+	daysInMonth := time.Date(first.Year(), first.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	var days []int
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = daysInMonth + d + 1
+			}
+			if d >= 1 && d <= daysInMonth {
+				days = append(days, d)
+			}
+		}
+	case len(r.ByDay) > 0:
+		for _, weekday := range r.ByDay {
+			// The first day of the month with the weekday.
+			firstDay := 1 + (int(weekday.Day)-int(first.Weekday())+7)%7
+			var matching []int
+			for d := firstDay; d <= daysInMonth; d += 7 {
+				matching = append(matching, d)
+			}
+			switch {
+			case weekday.N == 0:
+				days = append(days, matching...)
+			case weekday.N > 0 && weekday.N <= len(matching):
+				days = append(days, matching[weekday.N-1])
+			case weekday.N < 0 && -weekday.N <= len(matching):
+				days = append(days, matching[len(matching)+weekday.N])
+			}
+		}
+	default:
+		// Skip months without the day, e.g. the 31st.
+		if startDay <= daysInMonth {
+			days = append(days, startDay)
+		}
+	}
+
+	return days
+}
+
+// matchesWeekday returns true if the rule has no BYDAY list or the list contains the weekday.
+func (r *Rule) matchesWeekday(day time.Weekday) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, weekday := range r.ByDay {
+		if weekday.Day == day {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(times []time.Time) []time.Time {
+	results := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			results = append(results, t)
+		}
+	}
+	return results
+}
+
+func parsePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive number", name)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(untilFormat, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		// A date includes the whole day.
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("UNTIL must be a UTC date-time such as 20200401T180000Z")
+}
+
+func parseByDay(value string) ([]Weekday, error) {
+	var days []Weekday
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY value %q", item)
+		}
+		day, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY value %q", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid BYDAY value %q", item)
+			}
+		}
+		days = append(days, Weekday{Day: day, N: n})
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		d, err := strconv.Atoi(item)
+		if err != nil || d == 0 || d < -31 || d > 31 {
+			return nil, fmt.Errorf("invalid BYMONTHDAY value %q", item)
+		}
+		days = append(days, d)
+	}
+	return days, nil
+}
@@ -0,0 +1,157 @@
+package recurrence
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recurrence rule", func() {
+	var berlin *time.Location
+
+	BeforeEach(func() {
+		berlin, _ = time.LoadLocation("Europe/Berlin")
+	})
+
+	all := func(value string, start time.Time) []time.Time {
+		rule, err := Parse(value)
+		Expect(err).ToNot(HaveOccurred())
+		return rule.Between(start, start, start.AddDate(2, 0, 0))
+	}
+
+	dates := func(times []time.Time) []string {
+		results := make([]string, len(times))
+		for i, t := range times {
+			results[i] = t.Format("2006-01-02 15:04 MST")
+		}
+		return results
+	}
+
+	Describe("when a rule is parsed", func() {
+		It("should round-trip the supported parts", func() {
+			rule, err := Parse("RRULE:FREQ=MONTHLY;INTERVAL=2;UNTIL=20201231T000000Z;BYDAY=2WE,-1FR")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(rule.String()).To(Equal("FREQ=MONTHLY;INTERVAL=2;UNTIL=20201231T000000Z;BYDAY=2WE,-1FR"))
+		})
+
+		DescribeTable("should reject invalid rules",
+			func(value string) {
+				_, err := Parse(value)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("empty", ""),
+			Entry("missing frequency", "COUNT=3"),
+			Entry("unsupported frequency", "FREQ=HOURLY"),
+			Entry("count and until", "FREQ=DAILY;COUNT=3;UNTIL=20200101T000000Z"),
+			Entry("invalid weekday", "FREQ=WEEKLY;BYDAY=XX"),
+			Entry("numbered weekday in weekly rule", "FREQ=WEEKLY;BYDAY=2WE"),
+			Entry("invalid interval", "FREQ=DAILY;INTERVAL=0"),
+		)
+	})
+
+	Describe("when occurrences are calculated", func() {
+		It("should repeat weekly on the start weekday and keep the local time across DST", func() {
+			start := time.Date(2020, 3, 18, 19, 0, 0, 0, berlin)
+
+			Expect(dates(all("FREQ=WEEKLY;COUNT=3", start))).To(Equal([]string{
+				"2020-03-18 19:00 CET",
+				"2020-03-25 19:00 CET",
+				"2020-04-01 19:00 CEST",
+			}))
+		})
+
+		It("should repeat on multiple weekdays with an interval", func() {
+			start := time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC)
+
+			Expect(dates(all("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4", start))).To(Equal([]string{
+				"2020-04-01 19:00 UTC",
+				"2020-04-13 19:00 UTC",
+				"2020-04-15 19:00 UTC",
+				"2020-04-27 19:00 UTC",
+			}))
+		})
+
+		It("should repeat monthly on the last Friday", func() {
+			start := time.Date(2020, 1, 1, 18, 0, 0, 0, time.UTC)
+
+			Expect(dates(all("FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", start))).To(Equal([]string{
+				"2020-01-31 18:00 UTC",
+				"2020-02-28 18:00 UTC",
+				"2020-03-27 18:00 UTC",
+			}))
+		})
+
+		It("should skip months without the start day", func() {
+			start := time.Date(2020, 1, 31, 18, 0, 0, 0, time.UTC)
+
+			Expect(dates(all("FREQ=MONTHLY;COUNT=3", start))).To(Equal([]string{
+				"2020-01-31 18:00 UTC",
+				"2020-03-31 18:00 UTC",
+				"2020-05-31 18:00 UTC",
+			}))
+		})
+
+		It("should stop at the end date", func() {
+			start := time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+
+			Expect(all("FREQ=DAILY;UNTIL=20200403T180000Z", start)).To(HaveLen(3))
+		})
+
+		It("should only return occurrences within the window", func() {
+			rule, _ := Parse("FREQ=DAILY")
+			start := time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+
+			occurrences := rule.Between(start, time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC), time.Date(2020, 4, 12, 0, 0, 0, 0, time.UTC))
+			Expect(dates(occurrences)).To(Equal([]string{"2020-04-10 18:00 UTC", "2020-04-11 18:00 UTC"}))
+		})
+
+		It("should tell whether a time is an occurrence", func() {
+			rule, _ := Parse("FREQ=WEEKLY")
+			start := time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+
+			Expect(rule.Includes(start, start.AddDate(0, 0, 14))).To(BeTrue())
+			Expect(rule.Includes(start, start.AddDate(0, 0, 15))).To(BeFalse())
+		})
+
+		It("should ignore occurrences more than 100 years after the start", func() {
+			rule, _ := Parse("FREQ=DAILY")
+			start := time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+
+			Expect(rule.Includes(start, start.AddDate(99, 0, 0))).To(BeTrue())
+			Expect(rule.Includes(start, time.Date(9999, 12, 31, 18, 0, 0, 0, time.UTC))).To(BeFalse())
+			Expect(rule.Between(start, time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))).To(BeEmpty())
+		})
+
+		DescribeTable("should find the same occurrences far after the start as from the start",
+			func(value string) {
+				rule, err := Parse(value)
+				Expect(err).ToNot(HaveOccurred())
+				start := time.Date(2020, 3, 29, 1, 30, 0, 0, berlin)
+				from := time.Date(2045, 3, 20, 0, 0, 0, 0, berlin)
+				to := from.AddDate(0, 3, 0)
+
+				var expected []time.Time
+				rule.Iterate(start, func(t time.Time) bool {
+					if !t.Before(from) && t.Before(to) {
+						expected = append(expected, t)
+					}
+					return t.Before(to)
+				})
+
+				Expect(expected).ToNot(BeEmpty())
+				Expect(dates(rule.Between(start, from, to))).To(Equal(dates(expected)))
+				for _, t := range expected {
+					Expect(rule.Includes(start, t)).To(BeTrue())
+				}
+			},
+			Entry("daily", "FREQ=DAILY;INTERVAL=3"),
+			Entry("weekly", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SU"),
+			Entry("monthly", "FREQ=MONTHLY;BYDAY=-1FR"),
+			Entry("monthly on a day", "FREQ=MONTHLY;INTERVAL=5;BYMONTHDAY=31"),
+			Entry("yearly", "FREQ=YEARLY;INTERVAL=1"),
+		)
+	})
+})
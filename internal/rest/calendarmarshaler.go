@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/sebastianrosch/couchconnections/internal/calendar"
+	"github.com/sebastianrosch/couchconnections/internal/ical"
+	servicev1 "github.com/sebastianrosch/couchconnections/internal/service/v1"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var errCalendarUnmarshal = errors.New("text/calendar is not supported as request content type")
+
+// CalendarMarshaler is used to marshal events and lists of events as iCalendar objects.
+// All other messages, such as errors, are marshaled as JSON.
+type CalendarMarshaler struct {
+	runtime.JSONPb
+}
+
+type calendarEncoder struct {
+	marshaler *CalendarMarshaler
+	writer    io.Writer
+}
+
+func (c *calendarEncoder) Encode(v interface{}) error {
+	data, err := c.marshaler.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = c.writer.Write(data)
+	return err
+}
+
+// Marshal marshals "v" into byte sequence.
+func (c *CalendarMarshaler) Marshal(v interface{}) ([]byte, error) {
+	var events []*v1.Event
+	switch msg := v.(type) {
+	case *v1.Event:
+		events = []*v1.Event{msg}
+	case *v1.ListEventsResponse:
+		events = msg.GetEvents()
+	default:
+		return c.JSONPb.Marshal(v)
+	}
+
+	cal := &ical.Calendar{ProductID: calendar.ProductID}
+	for _, event := range events {
+		icalEvent, err := eventToICal(event)
+		if err != nil {
+			return nil, err
+		}
+		cal.Events = append(cal.Events, icalEvent)
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf, time.Now()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal returns an error, because iCalendar objects can't be unmarshaled into messages.
+func (c *CalendarMarshaler) Unmarshal(data []byte, v interface{}) error {
+	return errCalendarUnmarshal
+}
+
+// NewDecoder returns a Decoder that always fails, because iCalendar objects can't be unmarshaled into messages.
+func (c *CalendarMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		return errCalendarUnmarshal
+	})
+}
+
+// NewEncoder returns an Encoder which writes bytes sequence into "w".
+func (c *CalendarMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return &calendarEncoder{marshaler: c, writer: w}
+}
+
+// ContentType returns the Content-Type which this marshaler is responsible for.
+func (c *CalendarMarshaler) ContentType() string {
+	return ical.ContentType
+}
+
+// ContentTypeFromMessage returns the Content-Type of the marshaled message, which is JSON for all messages except events.
+func (c *CalendarMarshaler) ContentTypeFromMessage(v interface{}) string {
+	switch v.(type) {
+	case *v1.Event, *v1.ListEventsResponse:
+		return ical.ContentType
+	default:
+		return c.JSONPb.ContentType()
+	}
+}
+
+// eventToICal converts a protobuf event into an iCalendar event.
+// The join link is only set if the caller was allowed to see it.
+func eventToICal(event *v1.Event) (ical.Event, error) {
+	storeEvent, err := servicev1.EventFromProto(event)
+	if err != nil {
+		return ical.Event{}, err
+	}
+
+	icalEvent := calendar.ToICalEvent(storeEvent)
+	icalEvent.URL = event.GetZoomLink()
+	return icalEvent, nil
+}
@@ -0,0 +1,164 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+var errCSVUnmarshal = errors.New("text/csv is not supported as request content type")
+
+// CSVMarshaler is used to marshal messages as CSV.
+// List responses are written with one row per item of their first repeated message field,
+// all other messages are written as a single row. The header row contains the field names.
+type CSVMarshaler struct {
+}
+
+type csvEncoder struct {
+	marshaler *CSVMarshaler
+	writer    io.Writer
+}
+
+func (c *csvEncoder) Encode(v interface{}) error {
+	data, err := c.marshaler.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = c.writer.Write(data)
+	return err
+}
+
+// Marshal marshals "v" into byte sequence.
+func (c *CSVMarshaler) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't marshal %T as CSV", v)
+	}
+
+	jsonpb := runtime.JSONPb{OrigName: true, EmitDefaults: true}
+	data, err := jsonpb.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	// List responses are written with one row per item.
+	columns := csvColumns(reflect.TypeOf(msg))
+	rows := []map[string]interface{}{values}
+	if field, itemType, ok := listField(reflect.TypeOf(msg)); ok {
+		columns = csvColumns(itemType)
+		rows = nil
+		items, _ := values[field].([]interface{})
+		for _, item := range items {
+			if row, ok := item.(map[string]interface{}); ok {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(columns); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i], err = csvValue(row[column])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
+
+// Unmarshal returns an error, because CSV can't be unmarshaled into messages.
+func (c *CSVMarshaler) Unmarshal(data []byte, v interface{}) error {
+	return errCSVUnmarshal
+}
+
+// NewDecoder returns a Decoder that always fails, because CSV can't be unmarshaled into messages.
+func (c *CSVMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		return errCSVUnmarshal
+	})
+}
+
+// NewEncoder returns an Encoder which writes bytes sequence into "w".
+func (c *CSVMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return &csvEncoder{marshaler: c, writer: w}
+}
+
+// ContentType returns the Content-Type which this marshaler is responsible for.
+func (c *CSVMarshaler) ContentType() string {
+	return "text/csv"
+}
+
+// csvColumns returns the original names of the fields of a message type in the order they are declared.
+func csvColumns(t reflect.Type) []string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var columns []string
+	for _, prop := range proto.GetProperties(t).Prop {
+		if strings.HasPrefix(prop.Name, "XXX_") {
+			continue
+		}
+		columns = append(columns, prop.OrigName)
+	}
+
+	return columns
+}
+
+// listField returns the original name and the item type of the first repeated message field of a message type.
+func listField(t reflect.Type) (string, reflect.Type, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	props := proto.GetProperties(t)
+	for i, prop := range props.Prop {
+		if !prop.Repeated || strings.HasPrefix(prop.Name, "XXX_") {
+			continue
+		}
+		itemType := t.Field(i).Type.Elem()
+		if _, ok := reflect.Zero(itemType).Interface().(proto.Message); ok {
+			return prop.OrigName, itemType, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// csvValue formats a JSON value as CSV field. Objects and arrays are written as JSON.
+func csvValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool, float64:
+		return fmt.Sprint(v), nil
+	default:
+		data, err := json.Marshal(v)
+		return string(data), err
+	}
+}
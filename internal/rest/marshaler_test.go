@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Marshalers", func() {
+	var events *v1.ListEventsResponse
+
+	BeforeEach(func() {
+		start, _ := ptypes.TimestampProto(time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC))
+		end, _ := ptypes.TimestampProto(time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC))
+		events = &v1.ListEventsResponse{Events: []*v1.Event{{
+			Id:       "1",
+			Topic:    "How viruses spread, explained",
+			Start:    start,
+			End:      end,
+			TimeZone: "Europe/Berlin",
+			Status:   v1.EventStatus_EVENT_STATUS_SCHEDULED,
+		}}}
+	})
+
+	Describe("when events are marshaled as iCalendar", func() {
+		var marshaler *CalendarMarshaler
+
+		BeforeEach(func() {
+			marshaler = &CalendarMarshaler{JSONPb: runtime.JSONPb{OrigName: true}}
+		})
+
+		It("should write a calendar with the events", func() {
+			data, err := marshaler.Marshal(events)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(data)).To(HavePrefix("BEGIN:VCALENDAR\r\n"))
+			Expect(string(data)).To(ContainSubstring("UID:1@couchconnections\r\n"))
+			Expect(string(data)).To(ContainSubstring("DTSTART;TZID=Europe/Berlin:20200401T200000\r\n"))
+			Expect(string(data)).To(ContainSubstring("STATUS:CONFIRMED\r\n"))
+			Expect(marshaler.ContentTypeFromMessage(events)).To(Equal("text/calendar; charset=utf-8"))
+		})
+
+		It("should write other messages as JSON", func() {
+			status := &spb.Status{Code: 5, Message: "event 1 not found"}
+
+			data, err := marshaler.Marshal(status)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(data)).To(MatchJSON(`{"code": 5, "message": "event 1 not found"}`))
+			Expect(marshaler.ContentTypeFromMessage(status)).To(Equal("application/json"))
+		})
+
+		It("should not unmarshal requests", func() {
+			Expect(marshaler.Unmarshal([]byte("BEGIN:VCALENDAR"), &v1.Event{})).ToNot(Succeed())
+		})
+	})
+
+	Describe("when messages are marshaled as CSV", func() {
+		var marshaler *CSVMarshaler
+
+		BeforeEach(func() {
+			marshaler = &CSVMarshaler{}
+		})
+
+		It("should write one row per item of a list response", func() {
+			createdAt, _ := ptypes.TimestampProto(time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC))
+			registrations := &v1.ListRegistrationsResponse{Registrations: []*v1.Registration{
+				{Id: "1", EventId: "2", UserId: "alice", UserName: "Doe, Alice", Status: v1.RegistrationStatus_REGISTRATION_STATUS_CONFIRMED, CreatedAt: createdAt},
+				{Id: "3", EventId: "2", UserId: "bob", Status: v1.RegistrationStatus_REGISTRATION_STATUS_WAITLISTED, CreatedAt: createdAt},
+			}}
+
+			data, err := marshaler.Marshal(registrations)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(data)).To(Equal("id,event_id,user_id,user_name,status,created_at\n" +
+				`1,2,alice,"Doe, Alice",REGISTRATION_STATUS_CONFIRMED,2020-03-01T12:00:00Z` + "\n" +
+				"3,2,bob,,REGISTRATION_STATUS_WAITLISTED,2020-03-01T12:00:00Z\n"))
+		})
+
+		It("should write a single message as one row", func() {
+			data, err := marshaler.Marshal(&v1.Version{Version: "1.0.0", Branch: "master", Revision: "abc"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(data)).To(Equal("version,branch,revision\n1.0.0,master,abc\n"))
+		})
+	})
+})
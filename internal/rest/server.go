@@ -3,6 +3,7 @@ package rest
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -11,14 +12,27 @@ import (
 	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
 )
 
+// headerMatcher forwards the X-Api-Key header in addition to the default headers, so that API keys can be sent in it.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "X-Api-Key") {
+		return "x-api-key", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 // GetHandler returns the HTTP/REST gateway handler.
 func GetHandler(ctx context.Context, logger logr.Logger, host, grpcPort string) http.Handler {
-	// Register JSON and YAML marshaler.
+	// Register JSON, YAML, iCalendar and CSV marshaler.
 	json := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
 	yaml := &YamlMarshaler{}
+	calendar := &CalendarMarshaler{JSONPb: *json}
+	csv := &CSVMarshaler{}
 	opt := func(mux *runtime.ServeMux) {
 		runtime.WithMarshalerOption("application/json", json)(mux)
 		runtime.WithMarshalerOption("application/yaml", yaml)(mux)
+		runtime.WithMarshalerOption("text/calendar", calendar)(mux)
+		runtime.WithMarshalerOption("text/csv", csv)(mux)
+		runtime.WithIncomingHeaderMatcher(headerMatcher)(mux)
 	}
 	mux := runtime.NewServeMux(opt)
 
@@ -0,0 +1,102 @@
+package search
+
+import (
+	"math"
+	"sort"
+)
+
+// Result is a document that matches a search query.
+type Result struct {
+	ID    string
+	Score float64
+}
+
+// Index is an inverted index of documents with weighted text fields.
+// Documents are ranked by the weighted frequency of the query terms in their fields,
+// where rare terms count more than common ones.
+// Index is not safe for concurrent use.
+type Index struct {
+	weights map[string]int
+	// postings maps each term to the weighted frequency of the term in each document.
+	postings map[string]map[string]float64
+	// documents maps each document to its terms, so that it can be removed.
+	documents map[string][]string
+}
+
+// NewIndex returns an empty index. Only the fields with a weight are indexed.
+func NewIndex(weights map[string]int) *Index {
+	return &Index{
+		weights:   weights,
+		postings:  map[string]map[string]float64{},
+		documents: map[string][]string{},
+	}
+}
+
+// Add adds a document with the given fields to the index or replaces the document with the same ID.
+func (i *Index) Add(id string, fields map[string]string) {
+	i.Remove(id)
+
+	frequencies := map[string]float64{}
+	for field, text := range fields {
+		weight, ok := i.weights[field]
+		if !ok {
+			continue
+		}
+		counts := map[string]int{}
+		for _, t := range tokenize(text) {
+			counts[t.term]++
+		}
+		for term, count := range counts {
+			frequencies[term] += float64(weight) * (1 + math.Log(float64(count)))
+		}
+	}
+
+	terms := make([]string, 0, len(frequencies))
+	for term, frequency := range frequencies {
+		if i.postings[term] == nil {
+			i.postings[term] = map[string]float64{}
+		}
+		i.postings[term][id] = frequency
+		terms = append(terms, term)
+	}
+	i.documents[id] = terms
+}
+
+// Remove removes the document with the given ID from the index.
+func (i *Index) Remove(id string) {
+	for _, term := range i.documents[id] {
+		delete(i.postings[term], id)
+		if len(i.postings[term]) == 0 {
+			delete(i.postings, term)
+		}
+	}
+	delete(i.documents, id)
+}
+
+// Search returns the documents that contain any of the terms of the query, ordered by their score.
+func (i *Index) Search(query string) []Result {
+	scores := map[string]float64{}
+	for _, term := range Terms(query) {
+		postings := i.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+		idf := math.Log(1 + float64(len(i.documents))/float64(len(postings)))
+		for id, frequency := range postings {
+			scores[id] += frequency * idf
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for id, score := range scores {
+		results = append(results, Result{ID: id, Score: score})
+	}
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].Score == results[b].Score {
+			return results[a].ID < results[b].ID
+		}
+		return results[a].Score > results[b].Score
+	})
+
+	return results
+}
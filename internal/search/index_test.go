@@ -0,0 +1,52 @@
+package search
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Index", func() {
+	var index *Index
+
+	ids := func(results []Result) []string {
+		ids := make([]string, len(results))
+		for i, result := range results {
+			ids[i] = result.ID
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		index = NewIndex(map[string]int{"topic": 10, "description": 1})
+		index.Add("1", map[string]string{"topic": "How viruses spread", "description": "What we know about Corona."})
+		index.Add("2", map[string]string{"topic": "Corona and FinTech", "description": "Payments during the crisis."})
+		index.Add("3", map[string]string{"topic": "Baking bread", "description": "Sourdough for beginners."})
+	})
+
+	It("should rank matches in heavier fields first", func() {
+		Expect(ids(index.Search("corona"))).To(Equal([]string{"2", "1"}))
+	})
+
+	It("should match any of the terms regardless of case", func() {
+		Expect(ids(index.Search("FINTECH bread"))).To(ConsistOf("2", "3"))
+	})
+
+	It("should not index fields without weight", func() {
+		index.Add("4", map[string]string{"host": "Corona"})
+
+		Expect(ids(index.Search("corona"))).To(Equal([]string{"2", "1"}))
+	})
+
+	It("should not return removed documents", func() {
+		index.Remove("2")
+
+		Expect(ids(index.Search("corona"))).To(Equal([]string{"1"}))
+	})
+
+	It("should replace documents with the same ID", func() {
+		index.Add("1", map[string]string{"topic": "Remote work"})
+
+		Expect(ids(index.Search("corona"))).To(Equal([]string{"2"}))
+		Expect(ids(index.Search("remote"))).To(Equal([]string{"1"}))
+	})
+})
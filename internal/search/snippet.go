@@ -0,0 +1,83 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	// HighlightStart is written before each search term in a snippet.
+	HighlightStart = "<em>"
+	// HighlightEnd is written after each search term in a snippet.
+	HighlightEnd = "</em>"
+
+	// ellipsis marks text that was cut off from a snippet.
+	ellipsis = "…"
+)
+
+// Snippet returns the part of the text around the first search term with all search terms highlighted.
+// The snippet contains at most size bytes of the text and starts and ends at word boundaries.
+// The text is HTML-escaped, so that the snippet can be rendered as HTML with only the highlights as markup.
+// It returns an empty string if the text doesn't contain any of the terms.
+func Snippet(text string, terms []string, size int) string {
+	matches := map[string]bool{}
+	for _, term := range terms {
+		matches[term] = true
+	}
+
+	tokens := tokenize(text)
+	first := -1
+	for i, t := range tokens {
+		if matches[t.term] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	// Start a few words before the first match, so that it has some context.
+	begin := first
+	for begin > 0 && tokens[first].end-tokens[begin-1].start <= size/3 {
+		begin--
+	}
+	start := tokens[begin].start
+	if begin == 0 {
+		start = 0
+	}
+	end := begin
+	for end < len(tokens) && tokens[end].end-start <= size {
+		end++
+	}
+	if end == begin {
+		// The first word is longer than the snippet.
+		end = begin + 1
+	}
+	stop := tokens[end-1].end
+	if end == len(tokens) && len(text)-start <= size {
+		stop = len(text)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(ellipsis)
+	}
+	position := start
+	for _, t := range tokens[begin:end] {
+		if !matches[t.term] {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[position:t.start]))
+		b.WriteString(HighlightStart)
+		b.WriteString(html.EscapeString(text[t.start:t.end]))
+		b.WriteString(HighlightEnd)
+		position = t.end
+	}
+	b.WriteString(html.EscapeString(text[position:stop]))
+	if stop < len(text) {
+		b.WriteString(ellipsis)
+	}
+
+	return b.String()
+}
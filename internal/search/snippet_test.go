@@ -0,0 +1,28 @@
+package search
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snippet", func() {
+	It("should highlight all terms", func() {
+		Expect(Snippet("Corona and FinTech: corona!", []string{"corona", "fintech"}, 100)).
+			To(Equal("<em>Corona</em> and <em>FinTech</em>: <em>corona</em>!"))
+	})
+
+	It("should cut long texts around the first match", func() {
+		text := "We talk about many things tonight, mostly about Corona and how it changes our work in the next years."
+
+		Expect(Snippet(text, []string{"corona"}, 40)).To(Equal("…about <em>Corona</em> and how it changes our work…"))
+	})
+
+	It("should escape HTML in the text", func() {
+		Expect(Snippet(`<script>alert("corona")</script> & Corona`, []string{"corona"}, 100)).
+			To(Equal("&lt;script&gt;alert(&#34;<em>corona</em>&#34;)&lt;/script&gt; &amp; <em>Corona</em>"))
+	})
+
+	It("should return nothing if the text doesn't match", func() {
+		Expect(Snippet("Baking bread", []string{"corona"}, 40)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,49 @@
+// Package search provides a small in-memory full-text index and highlighting of search terms.
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token is a term and its position in a text.
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// tokenize splits a text into lower case terms of letters and digits.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		isTermRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isTermRune && start < 0:
+			start = i
+		case !isTermRune && start >= 0:
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+// Terms returns the distinct terms of a search query.
+func Terms(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, t := range tokenize(query) {
+		if !seen[t.term] {
+			seen[t.term] = true
+			terms = append(terms, t.term)
+		}
+	}
+
+	return terms
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+const (
+	// apiKeyPrefixLength is the number of random characters of a key that are stored to identify it.
+	apiKeyPrefixLength = 8
+	// apiKeyLastUsedInterval is how often the last used time of a key is updated,
+	// so that every request with a key doesn't write to the store.
+	apiKeyLastUsedInterval = time.Minute
+	// maxAPIKeyLifetime is the longest time a key is valid. Keys without expiry expire after it.
+	maxAPIKeyLifetime = 90 * 24 * time.Hour
+)
+
+// CreateAPIKey creates a personal API key of the authenticated user.
+// Only the hash of the key is stored, so the key is returned only once.
+// Keys expire after at most 90 days, which is also the default.
+func (s *CouchConnectionsService) CreateAPIKey(ctx context.Context, req *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "creating an API key requires an authenticated user")
+	}
+	// Otherwise a leaked key could be used to create keys that outlive it.
+	if auth.GetAPIKeyIDFromContext(ctx) != "" {
+		return nil, twirp.NewError(twirp.PermissionDenied, "API keys can't be created with an API key")
+	}
+
+	granted := map[string]bool{}
+	for _, permission := range auth.GetAuthorizationPermissionsFromContext(ctx) {
+		granted[permission] = true
+	}
+	for _, permission := range req.GetApiKey().GetPermissions() {
+		if !granted[permission] {
+			return nil, twirp.InvalidArgumentError("api_key.permissions", fmt.Sprintf("%q is not granted to the user", permission))
+		}
+	}
+
+	expiresAt := s.now().Add(maxAPIKeyLifetime)
+	if req.GetApiKey().GetExpiresAt() != nil {
+		var err error
+		if expiresAt, err = ptypes.Timestamp(req.GetApiKey().GetExpiresAt()); err != nil {
+			return nil, twirp.InvalidArgumentError("api_key.expires_at", err.Error())
+		}
+		if !expiresAt.After(s.now()) {
+			return nil, twirp.InvalidArgumentError("api_key.expires_at", "must be in the future")
+		}
+		if expiresAt.After(s.now().Add(maxAPIKeyLifetime)) {
+			return nil, twirp.InvalidArgumentError("api_key.expires_at", "must be within 90 days")
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	key := auth.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiKey := &store.APIKey{
+		ID:          store.NewID(),
+		UserID:      user.Sub,
+		UserName:    user.Name,
+		Name:        req.GetApiKey().GetName(),
+		Prefix:      key[:len(auth.APIKeyPrefix)+apiKeyPrefixLength],
+		KeyHash:     store.HashToken(key),
+		Permissions: req.GetApiKey().GetPermissions(),
+		CreatedAt:   s.now(),
+		ExpiresAt:   expiresAt,
+	}
+	if err := s.store.CreateAPIKey(apiKey); err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	resp, err := apiKeyToProto(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateAPIKeyResponse{ApiKey: resp, Key: key}, nil
+}
+
+// ListAPIKeys lists the API keys of the authenticated user.
+func (s *CouchConnectionsService) ListAPIKeys(ctx context.Context, req *empty.Empty) (*v1.ListAPIKeysResponse, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "listing API keys requires an authenticated user")
+	}
+
+	keys, err := s.store.ListAPIKeys(user.Sub)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	resp := &v1.ListAPIKeysResponse{}
+	for i := range keys {
+		apiKey, err := apiKeyToProto(&keys[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.ApiKeys = append(resp.ApiKeys, apiKey)
+	}
+
+	return resp, nil
+}
+
+// RevokeAPIKey revokes an API key of the authenticated user.
+func (s *CouchConnectionsService) RevokeAPIKey(ctx context.Context, req *v1.RevokeAPIKeyRequest) (*empty.Empty, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "revoking an API key requires an authenticated user")
+	}
+
+	if err := s.store.DeleteAPIKey(user.Sub, req.GetId()); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// APIKeyResolver validates API keys with the store and records when they are used.
+// Use NewAPIKeyResolver to build.
+type APIKeyResolver struct {
+	store store.APIKeyStore
+	now   func() time.Time
+}
+
+// ResolveAPIKey returns the identity of a valid API key or an InvalidAPIKeyError.
+// The key only has the permissions that its user had when they last authenticated with an access token.
+func (r *APIKeyResolver) ResolveAPIKey(ctx context.Context, key string) (*auth.APIKeyIdentity, error) {
+	apiKey, err := r.store.GetAPIKeyByHash(store.HashToken(key))
+	if store.IsNotFound(err) {
+		return nil, auth.NewInvalidAPIKeyError("the key is unknown or revoked")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now()
+	if apiKey.IsExpired(now) {
+		return nil, auth.NewInvalidAPIKeyError(fmt.Sprintf("the key %s expired", apiKey.Prefix))
+	}
+
+	granted := map[string]bool{}
+	userPermissions, err := r.store.GetUserPermissions(apiKey.UserID)
+	if err != nil && !store.IsNotFound(err) {
+		return nil, err
+	}
+	if userPermissions != nil {
+		for _, permission := range userPermissions.Permissions {
+			granted[permission] = true
+		}
+	}
+	permissions := []string{}
+	for _, permission := range apiKey.Permissions {
+		if granted[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	if now.Sub(apiKey.LastUsedAt) >= apiKeyLastUsedInterval {
+		// The key is valid even if the last used time can't be recorded.
+		_ = r.store.UpdateAPIKeyLastUsed(apiKey.ID, now)
+	}
+
+	return &auth.APIKeyIdentity{
+		ID:          apiKey.ID,
+		UserID:      apiKey.UserID,
+		UserName:    apiKey.UserName,
+		Permissions: permissions,
+	}, nil
+}
+
+// RecordPermissions records the permissions of a user who authenticated with an access token.
+// They are only written if they changed, so that not every request writes to the store.
+func (r *APIKeyResolver) RecordPermissions(ctx context.Context, userID string, permissions []string) error {
+	recorded, err := r.store.GetUserPermissions(userID)
+	if err != nil && !store.IsNotFound(err) {
+		return err
+	}
+	if recorded != nil && equalPermissions(recorded.Permissions, permissions) {
+		return nil
+	}
+
+	return r.store.SaveUserPermissions(&store.UserPermissions{
+		UserID:      userID,
+		Permissions: permissions,
+		UpdatedAt:   r.now(),
+	})
+}
+
+// NewAPIKeyResolver returns a new APIKeyResolver backed by the given store.
+func NewAPIKeyResolver(store store.APIKeyStore) *APIKeyResolver {
+	return &APIKeyResolver{store: store, now: time.Now}
+}
+
+// equalPermissions returns true if both slices contain the same permissions in any order.
+func equalPermissions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := map[string]int{}
+	for _, permission := range a {
+		counts[permission]++
+	}
+	for _, permission := range b {
+		counts[permission]--
+		if counts[permission] < 0 {
+			return false
+		}
+	}
+	return true
+}
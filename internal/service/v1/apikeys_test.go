@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("API keys", func() {
+	const writePermission = "capability:couchconnections:write"
+
+	var memoryStore *store.MemoryStore
+	var service *CouchConnectionsService
+	var resolver *APIKeyResolver
+	var now time.Time
+	var ctx context.Context
+
+	user := func(sub string) context.Context {
+		ctx := auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: sub, Name: "Anna"})
+		return auth.WithAuthorizationPermissions(ctx, []string{"capability:couchconnections:read", writePermission})
+	}
+
+	create := func(ctx context.Context, apiKey *v1.APIKey) (*v1.CreateAPIKeyResponse, error) {
+		return service.CreateAPIKey(ctx, &v1.CreateAPIKeyRequest{ApiKey: apiKey})
+	}
+
+	expectCode := func(err error, code twirp.ErrorCode) {
+		twerr, ok := err.(twirp.Error)
+		Expect(ok).To(BeTrue())
+		Expect(twerr.Code()).To(Equal(code))
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+		memoryStore = store.NewMemoryStore()
+		service = NewCouchConnectionsService(memoryStore, 15*time.Minute, newTestAuthorizer())
+		service.now = func() time.Time { return now }
+		resolver = NewAPIKeyResolver(memoryStore)
+		resolver.now = func() time.Time { return now }
+		ctx = user("anna")
+	})
+
+	Describe("when an API key is created", func() {
+		It("should return the key once and store its hash", func() {
+			resp, err := create(ctx, &v1.APIKey{Name: "importer", Permissions: []string{writePermission}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resp.Key).To(HavePrefix(auth.APIKeyPrefix))
+			Expect(resp.ApiKey.Prefix).To(HaveLen(len(auth.APIKeyPrefix) + 8))
+			Expect(strings.HasPrefix(resp.Key, resp.ApiKey.Prefix)).To(BeTrue())
+			Expect(resp.ApiKey.Name).To(Equal("importer"))
+			expiresAt, _ := ptypes.Timestamp(resp.ApiKey.ExpiresAt)
+			Expect(expiresAt).To(Equal(now.Add(90 * 24 * time.Hour)))
+
+			stored, err := memoryStore.GetAPIKeyByHash(store.HashToken(resp.Key))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.ID).To(Equal(resp.ApiKey.Id))
+			Expect(stored.UserID).To(Equal("anna"))
+		})
+
+		It("should reject permissions that aren't granted to the user", func() {
+			_, err := create(ctx, &v1.APIKey{Name: "importer", Permissions: []string{"capability:couchconnections:admin"}})
+
+			expectCode(err, twirp.InvalidArgument)
+		})
+
+		It("should reject an expiry in the past", func() {
+			expiresAt, _ := ptypes.TimestampProto(now.Add(-time.Minute))
+
+			_, err := create(ctx, &v1.APIKey{Name: "importer", Permissions: []string{writePermission}, ExpiresAt: expiresAt})
+
+			expectCode(err, twirp.InvalidArgument)
+		})
+
+		It("should reject an expiry more than 90 days ahead", func() {
+			expiresAt, _ := ptypes.TimestampProto(now.Add(91 * 24 * time.Hour))
+
+			_, err := create(ctx, &v1.APIKey{Name: "importer", Permissions: []string{writePermission}, ExpiresAt: expiresAt})
+
+			expectCode(err, twirp.InvalidArgument)
+		})
+
+		It("should reject requests authenticated with an API key", func() {
+			_, err := create(auth.WithAPIKeyID(ctx, "key"), &v1.APIKey{Name: "importer", Permissions: []string{writePermission}})
+
+			expectCode(err, twirp.PermissionDenied)
+		})
+
+		It("should reject anonymous requests", func() {
+			_, err := create(context.Background(), &v1.APIKey{Name: "importer", Permissions: []string{writePermission}})
+
+			expectCode(err, twirp.Unauthenticated)
+		})
+	})
+
+	Describe("when API keys are listed", func() {
+		It("should only return the keys of the user", func() {
+			first, err := create(ctx, &v1.APIKey{Name: "first", Permissions: []string{writePermission}})
+			Expect(err).ToNot(HaveOccurred())
+			second, err := create(ctx, &v1.APIKey{Name: "second", Permissions: []string{writePermission}})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = create(user("bob"), &v1.APIKey{Name: "other", Permissions: []string{writePermission}})
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := service.ListAPIKeys(ctx, &empty.Empty{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.ApiKeys).To(HaveLen(2))
+			Expect(resp.ApiKeys[0].Id).To(Equal(first.ApiKey.Id))
+			Expect(resp.ApiKeys[1].Id).To(Equal(second.ApiKey.Id))
+		})
+	})
+
+	Describe("when an API key is revoked", func() {
+		var created *v1.CreateAPIKeyResponse
+
+		BeforeEach(func() {
+			var err error
+			created, err = create(ctx, &v1.APIKey{Name: "importer", Permissions: []string{writePermission}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject the key", func() {
+			_, err := service.RevokeAPIKey(ctx, &v1.RevokeAPIKeyRequest{Id: created.ApiKey.Id})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = resolver.ResolveAPIKey(context.Background(), created.Key)
+			Expect(err).To(BeAssignableToTypeOf(&auth.InvalidAPIKeyError{}))
+		})
+
+		It("should not revoke the keys of other users", func() {
+			_, err := service.RevokeAPIKey(user("bob"), &v1.RevokeAPIKeyRequest{Id: created.ApiKey.Id})
+
+			Expect(store.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("when an API key is resolved", func() {
+		var created *v1.CreateAPIKeyResponse
+
+		BeforeEach(func() {
+			expiresAt, _ := ptypes.TimestampProto(now.Add(time.Hour))
+			var err error
+			created, err = create(ctx, &v1.APIKey{Name: "importer", Permissions: []string{writePermission}, ExpiresAt: expiresAt})
+			Expect(err).ToNot(HaveOccurred())
+			err = resolver.RecordPermissions(ctx, "anna", auth.GetAuthorizationPermissionsFromContext(ctx))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return the user and the permissions of the key", func() {
+			identity, err := resolver.ResolveAPIKey(context.Background(), created.Key)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identity.ID).To(Equal(created.ApiKey.Id))
+			Expect(identity.UserID).To(Equal("anna"))
+			Expect(identity.UserName).To(Equal("Anna"))
+			Expect(identity.Permissions).To(ConsistOf(writePermission))
+		})
+
+		It("should drop the permissions that were revoked from the user", func() {
+			err := resolver.RecordPermissions(ctx, "anna", []string{"capability:couchconnections:read"})
+			Expect(err).ToNot(HaveOccurred())
+
+			identity, err := resolver.ResolveAPIKey(context.Background(), created.Key)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identity.Permissions).To(BeEmpty())
+		})
+
+		It("should record when the key was last used at most once a minute", func() {
+			_, err := resolver.ResolveAPIKey(context.Background(), created.Key)
+			Expect(err).ToNot(HaveOccurred())
+			firstUse := now
+
+			now = now.Add(30 * time.Second)
+			_, err = resolver.ResolveAPIKey(context.Background(), created.Key)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := service.ListAPIKeys(ctx, &empty.Empty{})
+			Expect(err).ToNot(HaveOccurred())
+			lastUsedAt, _ := ptypes.Timestamp(resp.ApiKeys[0].LastUsedAt)
+			Expect(lastUsedAt).To(Equal(firstUse))
+		})
+
+		It("should reject expired keys", func() {
+			now = now.Add(time.Hour)
+
+			_, err := resolver.ResolveAPIKey(context.Background(), created.Key)
+
+			Expect(err).To(BeAssignableToTypeOf(&auth.InvalidAPIKeyError{}))
+		})
+
+		It("should reject unknown keys", func() {
+			_, err := resolver.ResolveAPIKey(context.Background(), auth.APIKeyPrefix+"unknown")
+
+			Expect(err).To(BeAssignableToTypeOf(&auth.InvalidAPIKeyError{}))
+		})
+	})
+})
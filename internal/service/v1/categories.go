@@ -0,0 +1,70 @@
+package service
+
+import (
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+)
+
+// defaultTagCloudSize is the number of tags returned if no limit is requested.
+const defaultTagCloudSize = 50
+
+// upcomingStatuses are the statuses of events that are counted in the tag cloud.
+var upcomingStatuses = []store.EventStatus{store.EventStatusScheduled, store.EventStatusLive}
+
+// validateCategory returns an InvalidArgument error if the category of the event doesn't exist.
+func (s *CouchConnectionsService) validateCategory(event *store.Event) error {
+	if event.CategoryID == "" {
+		return nil
+	}
+
+	_, err := s.store.GetCategoryByID(event.CategoryID)
+	if store.IsNotFound(err) {
+		return twirp.InvalidArgumentError("category_id", "must be the ID of an existing category")
+	}
+	if err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+
+	return nil
+}
+
+// countUpcomingTags returns the number of scheduled and live events per tag that haven't ended yet,
+// including the occurrences of series within the series horizon.
+func (s *CouchConnectionsService) countUpcomingTags() ([]store.TagCount, error) {
+	now := s.now()
+	query := &store.EventQuery{From: now, Statuses: upcomingStatuses}
+
+	counts, err := s.store.CountTags(query)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	occurrences, err := store.GetOccurrences(s.store, now, now.Add(seriesHorizon))
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	upcoming := occurrences[:0]
+	for i := range occurrences {
+		if query.Matches(&occurrences[i]) {
+			upcoming = append(upcoming, occurrences[i])
+		}
+	}
+
+	return store.MergeTagCounts(counts, store.CountTags(upcoming)), nil
+}
+
+// normalizeTags returns the distinct tags in their normalized form.
+func normalizeTags(tags []string) []string {
+	var results []string
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = normalize(tag)
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			results = append(results, tag)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Categories and tags", func() {
+	var service *CouchConnectionsService
+	var ctx context.Context
+	var now time.Time
+
+	newEvent := func(topic string, start time.Time, tags ...string) *v1.Event {
+		ts, _ := ptypes.TimestampProto(start)
+		return &v1.Event{
+			Topic:    topic,
+			Start:    ts,
+			Duration: ptypes.DurationProto(time.Hour),
+			Tags:     tags,
+		}
+	}
+
+	create := func(event *v1.Event) *v1.Event {
+		created, err := service.CreateEvent(withAdmin(ctx), &v1.CreateEventRequest{Event: event})
+		Expect(err).ToNot(HaveOccurred())
+		return created
+	}
+
+	BeforeEach(func() {
+		service = NewCouchConnectionsService(store.NewMemoryStore(), 15*time.Minute, newTestAuthorizer())
+		ctx = context.Background()
+		now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+		service.now = func() time.Time { return now }
+	})
+
+	Describe("when a category is managed", func() {
+		It("should be created by admins", func() {
+			category, err := service.CreateCategory(withAdmin(ctx), &v1.CreateCategoryRequest{Category: &v1.Category{Name: "Health"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(category.GetId()).ToNot(BeEmpty())
+
+			resp, err := service.ListCategories(ctx, &empty.Empty{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetCategories()).To(HaveLen(1))
+			Expect(resp.GetCategories()[0].GetName()).To(Equal("Health"))
+		})
+
+		It("should not be created by other users", func() {
+			_, err := service.CreateCategory(ctx, &v1.CreateCategoryRequest{Category: &v1.Category{Name: "Health"}})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.PermissionDenied))
+		})
+
+		It("should be removed from its events when it is deleted", func() {
+			category, err := service.CreateCategory(withAdmin(ctx), &v1.CreateCategoryRequest{Category: &v1.Category{Name: "Health"}})
+			Expect(err).ToNot(HaveOccurred())
+			event := newEvent("How viruses spread", now)
+			event.CategoryId = category.GetId()
+			created := create(event)
+
+			_, err = service.DeleteCategory(withAdmin(ctx), &v1.DeleteCategoryRequest{Id: category.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			fetched, err := service.GetEvent(withAdmin(ctx), &v1.GetEventRequest{Id: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched.GetCategoryId()).To(BeEmpty())
+		})
+	})
+
+	Describe("when an event is created", func() {
+		It("should reject an unknown category", func() {
+			event := newEvent("How viruses spread", now)
+			event.CategoryId = "unknown"
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Meta("argument")).To(Equal("category_id"))
+		})
+
+		It("should normalize its tags", func() {
+			created := create(newEvent("How viruses spread", now, "Corona", " corona ", "Public  Health"))
+
+			Expect(created.GetTags()).To(Equal([]string{"corona", "public health"}))
+		})
+	})
+
+	Describe("when events are listed by tag", func() {
+		It("should only return the events with the tag", func() {
+			create(newEvent("How viruses spread", now, "corona"))
+			create(newEvent("Baking bread", now.Add(time.Hour), "food"))
+
+			resp, err := service.ListEvents(ctx, &v1.ListEventsRequest{Tag: "Corona"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(HaveLen(1))
+			Expect(resp.GetEvents()[0].GetTopic()).To(Equal("How viruses spread"))
+		})
+	})
+
+	Describe("when the tag cloud is requested", func() {
+		It("should count the upcoming events and occurrences per tag", func() {
+			create(newEvent("How viruses spread", now.Add(time.Hour), "corona", "health"))
+			create(newEvent("Corona and FinTech", now.Add(2*time.Hour), "corona", "fintech"))
+			create(newEvent("Last year", now.AddDate(-1, 0, 0), "corona"))
+			draft := newEvent("Draft", now.Add(time.Hour), "health")
+			draft.Status = v1.EventStatus_EVENT_STATUS_DRAFT
+			create(draft)
+			series := newEvent("Morning yoga", now.Add(time.Hour), "health")
+			series.Recurrence = "FREQ=DAILY;COUNT=3"
+			create(series)
+
+			cloud, err := service.GetTagCloud(ctx, &v1.GetTagCloudRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloud.GetTags()).To(Equal([]*v1.TagCount{
+				{Tag: "health", Count: 4},
+				{Tag: "corona", Count: 2},
+				{Tag: "fintech", Count: 1},
+			}))
+		})
+	})
+})
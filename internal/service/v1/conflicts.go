@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+)
+
+const (
+	// hostConflict is the violation type of an event that overlaps with another event of the same host.
+	hostConflict = "HOST_CONFLICT"
+	// linkConflict is the violation type of an event that overlaps with another event with the same join link.
+	linkConflict = "LINK_CONFLICT"
+
+	// maxConflictDetails is the maximum number of conflicts returned in the error details.
+	maxConflictDetails = 10
+)
+
+// checkConflicts returns a FailedPrecondition error with the conflicting events as details if any of the
+// events overlaps with an existing event of the same host or with the same join link. Existing events for
+// which replaced returns true are ignored, because they are changed by the same request. Unpublished events
+// of other users are ignored too, so that the details never reveal events the caller can't see.
+// Admins can ignore conflicts.
+func (s *CouchConnectionsService) checkConflicts(ctx context.Context, events []store.Event, ignoreConflicts bool, replaced func(*store.Event) bool) error {
+	if ignoreConflicts {
+		if !s.isAdmin(ctx) {
+			return twirp.NewError(twirp.PermissionDenied, "only admins can ignore conflicts")
+		}
+		return nil
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	from, to := events[0].Start, events[0].End
+	for _, event := range events {
+		if event.Start.Before(from) {
+			from = event.Start
+		}
+		if event.End.After(to) {
+			to = event.End
+		}
+	}
+
+	existing, err := s.store.GetEventsBetween(from, to)
+	if err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+	occurrences, err := store.GetOccurrences(s.store, from, to)
+	if err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+	existing = append(existing, occurrences...)
+
+	var violations []*errdetails.PreconditionFailure_Violation
+	seen := map[string]bool{}
+	for i := range events {
+		if events[i].Status == store.EventStatusCancelled {
+			continue
+		}
+		for j := range existing {
+			other := &existing[j]
+			if seen[other.ID] || other.Status == store.EventStatusCancelled || replaced(other) || !s.canView(ctx, other) {
+				continue
+			}
+			if !other.Overlaps(events[i].Start, events[i].End) {
+				continue
+			}
+
+			violationType, reason := conflict(&events[i], other)
+			if violationType == "" {
+				continue
+			}
+			seen[other.ID] = true
+			violations = append(violations, &errdetails.PreconditionFailure_Violation{
+				Type:    violationType,
+				Subject: other.ID,
+				Description: fmt.Sprintf("%q from %s to %s %s",
+					other.Topic, other.Start.UTC().Format(time.RFC3339), other.End.UTC().Format(time.RFC3339), reason),
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("event conflicts with %d existing events", len(violations)))
+	if len(violations) > maxConflictDetails {
+		violations = violations[:maxConflictDetails]
+	}
+	st, err = st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+
+	return st.Err()
+}
+
+// conflict returns the violation type and a description if two overlapping events have the same host or join link.
+func conflict(event, other *store.Event) (string, string) {
+	if host := normalize(event.Host); host != "" && host == normalize(other.Host) {
+		return hostConflict, "is presented by the same host"
+	}
+	for _, id := range hostIDs(event) {
+		for _, otherID := range hostIDs(other) {
+			if id == otherID {
+				return hostConflict, "is presented by the same host"
+			}
+		}
+	}
+	if event.ZoomLink != "" && event.ZoomLink == other.ZoomLink {
+		return linkConflict, "uses the same join link"
+	}
+	return "", ""
+}
+
+// replacesNothing is used to check conflicts of new events.
+func replacesNothing(*store.Event) bool {
+	return false
+}
+
+// replacesEvent returns a function to check conflicts of an event that replaces the event with the given ID.
+func replacesEvent(id string) func(*store.Event) bool {
+	return func(event *store.Event) bool {
+		return event.ID == id
+	}
+}
+
+// replacesSeries returns a function to check conflicts of events that replace the occurrences
+// of a series that start at or after the given time.
+func replacesSeries(seriesID string, from time.Time) func(*store.Event) bool {
+	return func(event *store.Event) bool {
+		if event.SeriesID != seriesID {
+			return false
+		}
+		_, originalStart, ok := store.ParseOccurrenceID(event.ID)
+		return ok && !originalStart.Before(from)
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Conflict detection", func() {
+	var service *CouchConnectionsService
+	var ctx context.Context
+	var existing *v1.Event
+
+	newEvent := func(topic, host, link string, start time.Time) *v1.Event {
+		ts, _ := ptypes.TimestampProto(start)
+		return &v1.Event{
+			Topic:    topic,
+			Host:     host,
+			ZoomLink: link,
+			Start:    ts,
+			Duration: ptypes.DurationProto(time.Hour),
+			TimeZone: "Europe/Berlin",
+		}
+	}
+
+	violations := func(err error) []*errdetails.PreconditionFailure_Violation {
+		st := status.Convert(err)
+		Expect(st.Code()).To(Equal(codes.FailedPrecondition))
+		for _, detail := range st.Details() {
+			if failure, ok := detail.(*errdetails.PreconditionFailure); ok {
+				return failure.GetViolations()
+			}
+		}
+		Fail("error has no precondition failure details")
+		return nil
+	}
+
+	BeforeEach(func() {
+		service = NewCouchConnectionsService(store.NewMemoryStore(), 15*time.Minute, newTestAuthorizer())
+		ctx = context.Background()
+
+		var err error
+		existing, err = service.CreateEvent(withAdmin(ctx), &v1.CreateEventRequest{
+			Event: newEvent("How viruses spread", "Anna Berger", "https://zoom.us/j/1", time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("when an event overlaps with an event of the same host", func() {
+		It("should fail with the conflicting event", func() {
+			event := newEvent("Baking bread", " anna  berger", "", time.Date(2020, 4, 1, 18, 30, 0, 0, time.UTC))
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			details := violations(err)
+			Expect(details).To(HaveLen(1))
+			Expect(details[0].GetType()).To(Equal(hostConflict))
+			Expect(details[0].GetSubject()).To(Equal(existing.GetId()))
+		})
+	})
+
+	Describe("when an event overlaps with an event with the same join link", func() {
+		It("should fail with the conflicting event", func() {
+			event := newEvent("Baking bread", "Ben Kraus", "https://zoom.us/j/1", time.Date(2020, 4, 1, 17, 30, 0, 0, time.UTC))
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			details := violations(err)
+			Expect(details).To(HaveLen(1))
+			Expect(details[0].GetType()).To(Equal(linkConflict))
+		})
+	})
+
+	Describe("when an event overlaps with an unpublished event of another user", func() {
+		It("should be created without revealing the other event", func() {
+			anna := auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "anna"})
+			draft := newEvent("Secret plans", "Ben Kraus", "", time.Date(2020, 4, 2, 18, 0, 0, 0, time.UTC))
+			draft.Status = v1.EventStatus_EVENT_STATUS_DRAFT
+			_, err := service.CreateEvent(anna, &v1.CreateEventRequest{Event: draft})
+			Expect(err).ToNot(HaveOccurred())
+
+			ben := auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "ben"})
+			event := newEvent("Baking bread", "Ben Kraus", "", time.Date(2020, 4, 2, 18, 30, 0, 0, time.UTC))
+			_, err = service.CreateEvent(ben, &v1.CreateEventRequest{Event: event})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should still conflict with the events of the same user", func() {
+			anna := auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "anna"})
+			draft := newEvent("Secret plans", "Ben Kraus", "", time.Date(2020, 4, 2, 18, 0, 0, 0, time.UTC))
+			draft.Status = v1.EventStatus_EVENT_STATUS_DRAFT
+			created, err := service.CreateEvent(anna, &v1.CreateEventRequest{Event: draft})
+			Expect(err).ToNot(HaveOccurred())
+
+			event := newEvent("Baking bread", "Ben Kraus", "", time.Date(2020, 4, 2, 18, 30, 0, 0, time.UTC))
+			_, err = service.CreateEvent(anna, &v1.CreateEventRequest{Event: event})
+
+			details := violations(err)
+			Expect(details).To(HaveLen(1))
+			Expect(details[0].GetSubject()).To(Equal(created.GetId()))
+		})
+	})
+
+	Describe("when an event follows an event of the same host", func() {
+		It("should be created", func() {
+			event := newEvent("Baking bread", "Anna Berger", "https://zoom.us/j/1", time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC))
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("when an event is updated", func() {
+		It("should not conflict with itself", func() {
+			existing.Topic = "How viruses spread, part 1"
+
+			_, err := service.UpdateEvent(withAdmin(ctx), &v1.UpdateEventRequest{Id: existing.GetId(), Event: existing})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("when an occurrence of a series overlaps with an event of the same host", func() {
+		It("should fail to create the series", func() {
+			event := newEvent("Daily standup", "Anna Berger", "", time.Date(2020, 3, 30, 18, 0, 0, 0, time.UTC))
+			event.Recurrence = "FREQ=DAILY;COUNT=5"
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			details := violations(err)
+			Expect(details).To(HaveLen(1))
+			Expect(details[0].GetSubject()).To(Equal(existing.GetId()))
+		})
+	})
+
+	Describe("when conflicts are ignored", func() {
+		var event *v1.Event
+
+		BeforeEach(func() {
+			event = newEvent("Baking bread", "Anna Berger", "", time.Date(2020, 4, 1, 18, 30, 0, 0, time.UTC))
+		})
+
+		It("should create the event for admins", func() {
+			_, err := service.CreateEvent(withAdmin(ctx), &v1.CreateEventRequest{Event: event, IgnoreConflicts: true})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should deny other users", func() {
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event, IgnoreConflicts: true})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.PermissionDenied))
+		})
+	})
+})
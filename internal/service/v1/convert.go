@@ -0,0 +1,238 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var eventStatusFromProto = map[v1.EventStatus]store.EventStatus{
+	v1.EventStatus_EVENT_STATUS_DRAFT:     store.EventStatusDraft,
+	v1.EventStatus_EVENT_STATUS_SCHEDULED: store.EventStatusScheduled,
+	v1.EventStatus_EVENT_STATUS_LIVE:      store.EventStatusLive,
+	v1.EventStatus_EVENT_STATUS_FINISHED:  store.EventStatusFinished,
+	v1.EventStatus_EVENT_STATUS_CANCELLED: store.EventStatusCancelled,
+	v1.EventStatus_EVENT_STATUS_PENDING:   store.EventStatusPending,
+	v1.EventStatus_EVENT_STATUS_REJECTED:  store.EventStatusRejected,
+}
+
+var eventStatusToProto = map[store.EventStatus]v1.EventStatus{
+	store.EventStatusDraft:     v1.EventStatus_EVENT_STATUS_DRAFT,
+	store.EventStatusScheduled: v1.EventStatus_EVENT_STATUS_SCHEDULED,
+	store.EventStatusLive:      v1.EventStatus_EVENT_STATUS_LIVE,
+	store.EventStatusFinished:  v1.EventStatus_EVENT_STATUS_FINISHED,
+	store.EventStatusCancelled: v1.EventStatus_EVENT_STATUS_CANCELLED,
+	store.EventStatusPending:   v1.EventStatus_EVENT_STATUS_PENDING,
+	store.EventStatusRejected:  v1.EventStatus_EVENT_STATUS_REJECTED,
+}
+
+// EventFromProto converts a protobuf event into a store event.
+// The end time is calculated from the duration if it is not set.
+func EventFromProto(event *v1.Event) (*store.Event, error) {
+	start, err := ptypes.Timestamp(event.GetStart())
+	if err != nil {
+		return nil, err
+	}
+
+	var end time.Time
+	if event.GetEnd() != nil {
+		end, err = ptypes.Timestamp(event.GetEnd())
+		if err != nil {
+			return nil, err
+		}
+	} else if event.GetDuration() != nil {
+		duration, err := ptypes.Duration(event.GetDuration())
+		if err != nil {
+			return nil, err
+		}
+		end = start.Add(duration)
+	}
+
+	status, ok := eventStatusFromProto[event.GetStatus()]
+	if !ok {
+		status = store.EventStatusScheduled
+	}
+
+	timeZone := event.GetTimeZone()
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	return &store.Event{
+		ID:          event.GetId(),
+		Topic:       event.GetTopic(),
+		Description: event.GetDescription(),
+		Host:        event.GetHost(),
+		ZoomLink:    event.GetZoomLink(),
+		Start:       start,
+		End:         end,
+		TimeZone:    timeZone,
+		Language:    event.GetLanguage(),
+		Capacity:    int(event.GetCapacity()),
+		Status:      status,
+		Recurrence:  event.GetRecurrence(),
+		Tags:        normalizeTags(event.GetTags()),
+		CategoryID:  event.GetCategoryId(),
+		HostID:      event.GetHostId(),
+		CoHostIDs:   event.GetCoHostIds(),
+	}, nil
+}
+
+// eventToProto converts a store event into a protobuf event.
+func eventToProto(event *store.Event) (*v1.Event, error) {
+	start, err := ptypes.TimestampProto(event.Start)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	end, err := ptypes.TimestampProto(event.End)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return &v1.Event{
+		Id:              event.ID,
+		Topic:           event.Topic,
+		Description:     event.Description,
+		Host:            event.Host,
+		ZoomLink:        event.ZoomLink,
+		Start:           start,
+		End:             end,
+		Duration:        ptypes.DurationProto(event.Duration()),
+		TimeZone:        event.TimeZone,
+		Language:        event.Language,
+		Capacity:        uint32(event.Capacity),
+		Status:          eventStatusToProto[event.Status],
+		OwnerId:         event.OwnerID,
+		Recurrence:      event.Recurrence,
+		SeriesId:        event.SeriesID,
+		Tags:            event.Tags,
+		CategoryId:      event.CategoryID,
+		HostId:          event.HostID,
+		CoHostIds:       event.CoHostIDs,
+		RejectionReason: event.RejectionReason,
+	}, nil
+}
+
+var registrationStatusToProto = map[store.RegistrationStatus]v1.RegistrationStatus{
+	store.RegistrationStatusConfirmed:  v1.RegistrationStatus_REGISTRATION_STATUS_CONFIRMED,
+	store.RegistrationStatusWaitlisted: v1.RegistrationStatus_REGISTRATION_STATUS_WAITLISTED,
+}
+
+// registrationToProto converts a store registration into a protobuf registration.
+func registrationToProto(registration *store.Registration) (*v1.Registration, error) {
+	createdAt, err := ptypes.TimestampProto(registration.CreatedAt)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return &v1.Registration{
+		Id:        registration.ID,
+		EventId:   registration.EventID,
+		UserId:    registration.UserID,
+		UserName:  registration.UserName,
+		Status:    registrationStatusToProto[registration.Status],
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// categoryToProto converts a store category into a protobuf category.
+func categoryToProto(category *store.Category) *v1.Category {
+	return &v1.Category{
+		Id:          category.ID,
+		Name:        category.Name,
+		Description: category.Description,
+	}
+}
+
+// hostToProto converts a store host into a protobuf host.
+func hostToProto(host *store.Host) (*v1.Host, error) {
+	var updatedAt *timestamp.Timestamp
+	if !host.UpdatedAt.IsZero() {
+		var err error
+		if updatedAt, err = ptypes.TimestampProto(host.UpdatedAt); err != nil {
+			return nil, twirp.InternalErrorWith(err)
+		}
+	}
+
+	links := make([]*v1.HostLink, 0, len(host.Links))
+	for _, link := range host.Links {
+		links = append(links, &v1.HostLink{Title: link.Title, Url: link.URL})
+	}
+
+	return &v1.Host{
+		Id:        host.ID,
+		UserId:    host.UserID,
+		Name:      host.Name,
+		Bio:       host.Bio,
+		AvatarUrl: host.AvatarURL,
+		Links:     links,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// hostFromProto converts a protobuf host into a store host.
+func hostFromProto(host *v1.Host) *store.Host {
+	var links []store.HostLink
+	for _, link := range host.GetLinks() {
+		links = append(links, store.HostLink{Title: link.GetTitle(), URL: link.GetUrl()})
+	}
+
+	return &store.Host{
+		ID:        host.GetId(),
+		UserID:    host.GetUserId(),
+		Name:      host.GetName(),
+		Bio:       host.GetBio(),
+		AvatarURL: host.GetAvatarUrl(),
+		Links:     links,
+	}
+}
+
+// normalize returns the text in lower case with single spaces between words,
+// so that names and tags can be compared.
+func normalize(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
+
+// apiKeyToProto converts a store API key into a protobuf API key without the hash of the key.
+func apiKeyToProto(key *store.APIKey) (*v1.APIKey, error) {
+	createdAt, err := ptypes.TimestampProto(key.CreatedAt)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	expiresAt, err := optionalTimestampProto(key.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	lastUsedAt, err := optionalTimestampProto(key.LastUsedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.APIKey{
+		Id:          key.ID,
+		Name:        key.Name,
+		Prefix:      key.Prefix,
+		Permissions: key.Permissions,
+		CreatedAt:   createdAt,
+		ExpiresAt:   expiresAt,
+		LastUsedAt:  lastUsedAt,
+	}, nil
+}
+
+// optionalTimestampProto converts a time into a protobuf timestamp. A zero time is converted into nil.
+func optionalTimestampProto(t time.Time) (*timestamp.Timestamp, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	return ts, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+)
+
+// defaultHostEventsPageSize is the number of upcoming and past events of a host returned if no page size is requested.
+const defaultHostEventsPageSize = 20
+
+// resolveHosts checks that the host profiles of an event exist and sets the host name from the profile
+// if the event has none. Co-hosts are deduplicated and the host is removed from them.
+func (s *CouchConnectionsService) resolveHosts(event *store.Event) error {
+	if event.HostID != "" {
+		host, err := s.store.GetHostByID(event.HostID)
+		if store.IsNotFound(err) {
+			return twirp.InvalidArgumentError("host_id", "must be the ID of an existing host")
+		}
+		if err != nil {
+			return twirp.InternalErrorWith(err)
+		}
+		if event.Host == "" {
+			event.Host = host.Name
+		}
+	}
+
+	var coHostIDs []string
+	seen := map[string]bool{event.HostID: true}
+	for _, id := range event.CoHostIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, err := s.store.GetHostByID(id); err != nil {
+			if store.IsNotFound(err) {
+				return twirp.InvalidArgumentError("co_host_ids", "must be the IDs of existing hosts")
+			}
+			return twirp.InternalErrorWith(err)
+		}
+		coHostIDs = append(coHostIDs, id)
+	}
+	event.CoHostIDs = coHostIDs
+
+	return nil
+}
+
+// getMyHost returns the host profile of the user or a new profile with the name and picture of the user.
+func (s *CouchConnectionsService) getMyHost(user *auth.UserInfoResponse) (*store.Host, error) {
+	host, err := s.store.GetHostByUserID(user.Sub)
+	if store.IsNotFound(err) {
+		return &store.Host{UserID: user.Sub, Name: user.Name, AvatarURL: user.Picture}, nil
+	}
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return host, nil
+}
+
+// listHostEvents returns the upcoming events of a host, earliest first, and the past events, latest first.
+func (s *CouchConnectionsService) listHostEvents(ctx context.Context, hostID string, limit int) ([]store.Event, []store.Event, error) {
+	now := s.now()
+	published := !s.isAdmin(ctx)
+
+	upcoming, _, err := s.listEvents(store.EventQuery{HostID: hostID, From: now, Published: published, Limit: limit})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Events that haven't ended yet start before now, too, but are only returned as upcoming.
+	started, _, err := s.listEvents(store.EventQuery{HostID: hostID, To: now, Published: published, Order: store.SortDescending, Limit: limit + len(upcoming)})
+	if err != nil {
+		return nil, nil, err
+	}
+	past := make([]store.Event, 0, len(started))
+	for _, event := range started {
+		if !event.End.After(now) && len(past) < limit {
+			past = append(past, event)
+		}
+	}
+
+	caller, err := s.resolveEventCaller(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, events := range [][]store.Event{upcoming, past} {
+		for i := range events {
+			if err := s.redactJoinLink(caller, &events[i]); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return upcoming, past, nil
+}
+
+// hostIDs returns the IDs of the host and co-hosts of an event.
+func hostIDs(event *store.Event) []string {
+	if event.HostID == "" {
+		return event.CoHostIDs
+	}
+	return append([]string{event.HostID}, event.CoHostIDs...)
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Host profiles", func() {
+	var service *CouchConnectionsService
+	var anna, ben context.Context
+	var now time.Time
+
+	newEvent := func(topic string, start time.Time) *v1.Event {
+		ts, _ := ptypes.TimestampProto(start)
+		return &v1.Event{Topic: topic, Start: ts, Duration: ptypes.DurationProto(time.Hour)}
+	}
+
+	saveProfile := func(ctx context.Context, bio string) *v1.Host {
+		host, err := service.UpdateMyHostProfile(ctx, &v1.UpdateMyHostProfileRequest{Host: &v1.Host{Bio: bio}})
+		Expect(err).ToNot(HaveOccurred())
+		return host
+	}
+
+	BeforeEach(func() {
+		service = NewCouchConnectionsService(store.NewMemoryStore(), 15*time.Minute, newTestAuthorizer())
+		anna = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "anna", Name: "Anna Berger", Picture: "https://example.com/anna.png"})
+		ben = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "ben", Name: "Ben Kraus"})
+		now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+		service.now = func() time.Time { return now }
+	})
+
+	Describe("when a user has no profile", func() {
+		It("should return a profile with the identity of the user", func() {
+			host, err := service.GetMyHostProfile(anna, &empty.Empty{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(host.GetId()).To(BeEmpty())
+			Expect(host.GetName()).To(Equal("Anna Berger"))
+			Expect(host.GetAvatarUrl()).To(Equal("https://example.com/anna.png"))
+		})
+
+		It("should require an authenticated user", func() {
+			_, err := service.GetMyHostProfile(context.Background(), &empty.Empty{})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.Unauthenticated))
+		})
+	})
+
+	Describe("when a profile is updated", func() {
+		It("should keep its ID", func() {
+			created := saveProfile(anna, "Epidemiologist")
+			updated := saveProfile(anna, "Epidemiologist and baker")
+
+			Expect(updated.GetId()).To(Equal(created.GetId()))
+			Expect(updated.GetUserId()).To(Equal("anna"))
+
+			host, err := service.GetHost(ben, &v1.GetHostRequest{Id: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(host.GetBio()).To(Equal("Epidemiologist and baker"))
+		})
+	})
+
+	Describe("when an event references hosts", func() {
+		var annaHost, benHost *v1.Host
+
+		BeforeEach(func() {
+			annaHost = saveProfile(anna, "")
+			benHost = saveProfile(ben, "")
+		})
+
+		It("should use the name of the host profile", func() {
+			event := newEvent("How viruses spread", now)
+			event.HostId = annaHost.GetId()
+
+			created, err := service.CreateEvent(anna, &v1.CreateEventRequest{Event: event})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.GetHost()).To(Equal("Anna Berger"))
+		})
+
+		It("should reject unknown hosts", func() {
+			event := newEvent("How viruses spread", now)
+			event.CoHostIds = []string{"unknown"}
+
+			_, err := service.CreateEvent(anna, &v1.CreateEventRequest{Event: event})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Meta("argument")).To(Equal("co_host_ids"))
+		})
+
+		It("should conflict with overlapping events of a co-host", func() {
+			event := newEvent("How viruses spread", now)
+			event.HostId = annaHost.GetId()
+			event.CoHostIds = []string{benHost.GetId()}
+			_, err := service.CreateEvent(withAdmin(anna), &v1.CreateEventRequest{Event: event})
+			Expect(err).ToNot(HaveOccurred())
+
+			other := newEvent("Baking bread", now.Add(30*time.Minute))
+			other.HostId = benHost.GetId()
+			_, err = service.CreateEvent(ben, &v1.CreateEventRequest{Event: other})
+
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("should list the upcoming and past events of a host and co-host", func() {
+			for i, start := range []time.Time{now.AddDate(0, 0, -14), now.AddDate(0, 0, -7), now.AddDate(0, 0, 7)} {
+				event := newEvent("Talk", start)
+				if i == 1 {
+					event.HostId = annaHost.GetId()
+					event.CoHostIds = []string{benHost.GetId()}
+				} else {
+					event.HostId = benHost.GetId()
+				}
+				_, err := service.CreateEvent(withAdmin(ben), &v1.CreateEventRequest{Event: event})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			resp, err := service.ListHostEvents(anna, &v1.ListHostEventsRequest{Id: benHost.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resp.GetHost().GetName()).To(Equal("Ben Kraus"))
+			Expect(resp.GetUpcoming()).To(HaveLen(1))
+			Expect(resp.GetPast()).To(HaveLen(2))
+			Expect(resp.GetPast()[0].GetHostId()).To(Equal(annaHost.GetId()))
+		})
+	})
+})
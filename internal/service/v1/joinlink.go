@@ -0,0 +1,48 @@
+package service
+
+import (
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+)
+
+// redactJoinLink removes the join link from the event unless the caller is allowed to see it.
+// The owners and admins always see the link, confirmed attendees only within the join link window.
+func (s *CouchConnectionsService) redactJoinLink(caller *eventCaller, event *store.Event) error {
+	if event.ZoomLink == "" || caller.owns(event) {
+		return nil
+	}
+
+	// Check the window first, so that the registration is only looked up for upcoming events.
+	if caller.userID != "" && s.isWithinJoinLinkWindow(event) {
+		registered, err := s.isConfirmedAttendee(event.ID, caller.userID)
+		if err != nil {
+			return err
+		}
+		if registered {
+			return nil
+		}
+	}
+
+	event.ZoomLink = ""
+	return nil
+}
+
+// isWithinJoinLinkWindow returns true if attendees can get the join link of the event at the current time.
+func (s *CouchConnectionsService) isWithinJoinLinkWindow(event *store.Event) bool {
+	now := s.now()
+	return !now.Before(event.Start.Add(-s.joinLinkWindow)) && now.Before(event.End)
+}
+
+// isConfirmedAttendee returns true if the user has a confirmed registration for the event.
+func (s *CouchConnectionsService) isConfirmedAttendee(eventID, userID string) (bool, error) {
+	registration, err := s.store.GetRegistration(eventID, userID)
+	if store.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, twirp.InternalErrorWith(err)
+	}
+
+	return registration.Status == store.RegistrationStatusConfirmed, nil
+}
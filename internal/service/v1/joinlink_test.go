@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Join links", func() {
+	var service *CouchConnectionsService
+	var memoryStore *store.MemoryStore
+	var owner, attendee, stranger context.Context
+	var start time.Time
+	var eventID string
+
+	BeforeEach(func() {
+		memoryStore = store.NewMemoryStore()
+		service = NewCouchConnectionsService(memoryStore, 15*time.Minute, newTestAuthorizer())
+		owner = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "owner"})
+		attendee = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "attendee"})
+		stranger = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "stranger"})
+
+		start = time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+		startProto, _ := ptypes.TimestampProto(start)
+		created, err := service.CreateEvent(withAdmin(owner), &v1.CreateEventRequest{Event: &v1.Event{
+			Topic:    "How viruses spread",
+			ZoomLink: "https://zoom.us/j/123456789",
+			Start:    startProto,
+			Duration: ptypes.DurationProto(time.Hour),
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		eventID = created.GetId()
+
+		_, err = service.RegisterForEvent(attendee, &v1.RegisterForEventRequest{EventId: eventID})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	setNow := func(now time.Time) {
+		service.now = func() time.Time { return now }
+	}
+
+	Describe("when an event is read", func() {
+		It("should always reveal the link to the owner", func() {
+			setNow(start.Add(-24 * time.Hour))
+
+			event, err := service.GetEvent(owner, &v1.GetEventRequest{Id: eventID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetZoomLink()).To(Equal("https://zoom.us/j/123456789"))
+		})
+
+		It("should hide the link from attendees before the window opens", func() {
+			setNow(start.Add(-time.Hour))
+
+			event, err := service.GetEvent(attendee, &v1.GetEventRequest{Id: eventID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetZoomLink()).To(BeEmpty())
+		})
+
+		It("should reveal the link to attendees within the window", func() {
+			setNow(start.Add(-10 * time.Minute))
+
+			resp, err := service.ListEvents(attendee, &v1.ListEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()[0].GetZoomLink()).To(Equal("https://zoom.us/j/123456789"))
+		})
+
+		It("should hide the link from users who are not registered", func() {
+			setNow(start.Add(-10 * time.Minute))
+
+			resp, err := service.ListEvents(stranger, &v1.ListEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()[0].GetZoomLink()).To(BeEmpty())
+		})
+	})
+
+	Describe("when the join link is requested", func() {
+		It("should return the link and record the access", func() {
+			setNow(start.Add(-10 * time.Minute))
+
+			link, err := service.GetJoinLink(attendee, &v1.GetJoinLinkRequest{Id: eventID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(link.GetZoomLink()).To(Equal("https://zoom.us/j/123456789"))
+
+			entries, err := memoryStore.ListAuditEntries(eventID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].UserID).To(Equal("attendee"))
+			Expect(entries[0].Action).To(Equal(store.AuditActionJoinLinkAccessed))
+		})
+
+		It("should deny users who are not registered", func() {
+			setNow(start.Add(-10 * time.Minute))
+
+			_, err := service.GetJoinLink(stranger, &v1.GetJoinLinkRequest{Id: eventID})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.PermissionDenied))
+		})
+
+		It("should refuse attendees before the window opens", func() {
+			setNow(start.Add(-time.Hour))
+
+			_, err := service.GetJoinLink(attendee, &v1.GetJoinLinkRequest{Id: eventID})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.FailedPrecondition))
+			Expect(twerr.Meta("available_from")).To(Equal("2020-04-01T17:45:00Z"))
+
+			entries, _ := memoryStore.ListAuditEntries(eventID)
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
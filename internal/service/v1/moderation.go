@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+)
+
+// moderatedStatus returns the status of a created or updated event. Admins can set any status.
+// Other users can save drafts, but their submissions are pending until an admin approves them.
+// Once an event is approved, its owner can change its status, but not withdraw the approval.
+func (s *CouchConnectionsService) moderatedStatus(ctx context.Context, requested store.EventStatus, existing *store.Event) store.EventStatus {
+	if s.isAdmin(ctx) {
+		return requested
+	}
+
+	approved := existing != nil && existing.IsPublished()
+	if !approved {
+		if requested == store.EventStatusDraft {
+			return store.EventStatusDraft
+		}
+		return store.EventStatusPending
+	}
+
+	requestedEvent := store.Event{Status: requested}
+	if !requestedEvent.IsPublished() {
+		return existing.Status
+	}
+	return requested
+}
+
+// canView returns true if the user of the request can see the event. Events that are not
+// published are only visible to their owner and to admins.
+func (s *CouchConnectionsService) canView(ctx context.Context, event *store.Event) bool {
+	if event.IsPublished() || s.isAdmin(ctx) {
+		return true
+	}
+
+	user := auth.GetUserInfoFromContext(ctx)
+	return user != nil && user.Sub != "" && event.OwnerID == user.Sub
+}
+
+// getVisibleEvent returns the event with the given ID or a NotFoundError if it doesn't exist
+// or the user of the request can't see it.
+func (s *CouchConnectionsService) getVisibleEvent(ctx context.Context, id string) (*store.Event, error) {
+	event, err := s.store.GetEventByID(id)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if !s.canView(ctx, event) {
+		return nil, store.NewNotFoundError("event", id)
+	}
+
+	return event, nil
+}
+
+// listPendingEvents returns a page of the pending events and the first occurrences of pending series.
+func (s *CouchConnectionsService) listPendingEvents(query store.EventQuery) ([]store.Event, bool, error) {
+	limit := query.Limit
+	query.Limit = limit + 1
+	events, err := s.store.ListEvents(&query)
+	if err != nil {
+		return nil, false, twirp.InternalErrorWith(err)
+	}
+
+	allSeries, err := s.store.GetAllSeries()
+	if err != nil {
+		return nil, false, twirp.InternalErrorWith(err)
+	}
+	for i := range allSeries {
+		first, err := allSeries[i].Occurrence(allSeries[i].Event.Start)
+		if err != nil {
+			return nil, false, twirp.InternalErrorWith(err)
+		}
+		if query.Matches(first) {
+			events = append(events, *first)
+		}
+	}
+
+	events, more := page(&query, events, limit)
+	return events, more, nil
+}
+
+// moderate sets the status of a pending event or series and records the decision in the audit log.
+func (s *CouchConnectionsService) moderate(ctx context.Context, id string, status store.EventStatus, action store.AuditAction, reason string) (*store.Event, error) {
+	if err := s.assertAdmin(ctx, "only admins can moderate events"); err != nil {
+		return nil, err
+	}
+
+	subject := id
+	if seriesID, _, ok := store.ParseOccurrenceID(id); ok {
+		subject = seriesID
+		if err := s.moderateSeries(seriesID, status, reason); err != nil {
+			return nil, err
+		}
+	} else {
+		event, err := s.store.GetEventByID(id)
+		if err != nil {
+			return nil, storeError(err)
+		}
+		if event.Status != store.EventStatusPending {
+			return nil, twirp.NewError(twirp.FailedPrecondition, "event is "+string(event.Status))
+		}
+		event.Status = status
+		event.RejectionReason = reason
+		if _, err := s.store.UpdateEvent(event); err != nil {
+			return nil, storeError(err)
+		}
+	}
+
+	entry := &store.AuditEntry{
+		EventID:   subject,
+		Action:    action,
+		Reason:    reason,
+		CreatedAt: s.now(),
+	}
+	if user := auth.GetUserInfoFromContext(ctx); user != nil {
+		entry.UserID = user.Sub
+	}
+	if err := s.store.RecordAuditEntry(entry); err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	event, err := s.store.GetEventByID(id)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return event, nil
+}
+
+// moderateSeries sets the status of a pending series and of its pending occurrences.
+func (s *CouchConnectionsService) moderateSeries(seriesID string, status store.EventStatus, reason string) error {
+	series, err := s.store.GetSeriesByID(seriesID)
+	if err != nil {
+		return storeError(err)
+	}
+	if series.Event.Status != store.EventStatusPending {
+		return twirp.NewError(twirp.FailedPrecondition, "series is "+string(series.Event.Status))
+	}
+
+	series.Event.Status = status
+	series.Event.RejectionReason = reason
+	for i, exception := range series.Exceptions {
+		if exception.Event == nil || exception.Event.Status != store.EventStatusPending {
+			continue
+		}
+		// The exception event is shared with the stored series, so it is copied before the change.
+		event := *exception.Event
+		event.Status = status
+		event.RejectionReason = reason
+		series.Exceptions[i].Event = &event
+	}
+
+	if _, err := s.store.UpdateSeries(series); err != nil {
+		return storeError(err)
+	}
+	return nil
+}
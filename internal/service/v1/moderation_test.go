@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Moderation", func() {
+	var service *CouchConnectionsService
+	var memoryStore *store.MemoryStore
+	var anna, ben, admin context.Context
+	var submitted *v1.Event
+
+	BeforeEach(func() {
+		memoryStore = store.NewMemoryStore()
+		service = NewCouchConnectionsService(memoryStore, 15*time.Minute, newTestAuthorizer())
+		anna = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "anna", Name: "Anna Berger"})
+		ben = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "ben", Name: "Ben Kraus"})
+		admin = withAdmin(auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "admin"}))
+
+		start, _ := ptypes.TimestampProto(time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC))
+		var err error
+		submitted, err = service.CreateEvent(anna, &v1.CreateEventRequest{Event: &v1.Event{
+			Topic:    "How viruses spread",
+			Start:    start,
+			Duration: ptypes.DurationProto(time.Hour),
+			Status:   v1.EventStatus_EVENT_STATUS_SCHEDULED,
+		}})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("when a user submits an event", func() {
+		It("should be pending", func() {
+			Expect(submitted.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_PENDING))
+		})
+
+		It("should only be visible to its owner and admins", func() {
+			_, err := service.GetEvent(anna, &v1.GetEventRequest{Id: submitted.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = service.GetEvent(admin, &v1.GetEventRequest{Id: submitted.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.GetEvent(ben, &v1.GetEventRequest{Id: submitted.GetId()})
+			Expect(store.IsNotFound(err)).To(BeTrue())
+
+			resp, err := service.ListEvents(ben, &v1.ListEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(BeEmpty())
+
+			resp, err = service.ListEvents(anna, &v1.ListEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(HaveLen(1))
+			Expect(resp.GetEvents()[0].GetId()).To(Equal(submitted.GetId()))
+		})
+
+		It("should stay pending when its owner updates it", func() {
+			submitted.Topic = "How viruses spread, part 1"
+
+			updated, err := service.UpdateEvent(anna, &v1.UpdateEventRequest{Id: submitted.GetId(), Event: submitted})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_PENDING))
+		})
+	})
+
+	Describe("when pending events are listed", func() {
+		It("should return them to admins", func() {
+			resp, err := service.ListPendingEvents(admin, &v1.ListPendingEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(HaveLen(1))
+			Expect(resp.GetEvents()[0].GetId()).To(Equal(submitted.GetId()))
+		})
+
+		It("should deny other users", func() {
+			_, err := service.ListPendingEvents(anna, &v1.ListPendingEventsRequest{})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.PermissionDenied))
+		})
+	})
+
+	Describe("when an event is approved", func() {
+		It("should be published", func() {
+			approved, err := service.ApproveEvent(admin, &v1.ApproveEventRequest{Id: submitted.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approved.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_SCHEDULED))
+
+			resp, err := service.ListEvents(ben, &v1.ListEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(HaveLen(1))
+
+			entries, err := memoryStore.ListAuditEntries(submitted.GetId())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Action).To(Equal(store.AuditActionEventApproved))
+			Expect(entries[0].UserID).To(Equal("admin"))
+		})
+
+		It("should not be approved twice", func() {
+			_, err := service.ApproveEvent(admin, &v1.ApproveEventRequest{Id: submitted.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.ApproveEvent(admin, &v1.ApproveEventRequest{Id: submitted.GetId()})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.FailedPrecondition))
+		})
+	})
+
+	Describe("when an event is rejected", func() {
+		It("should keep the reason and stay hidden", func() {
+			rejected, err := service.RejectEvent(admin, &v1.RejectEventRequest{Id: submitted.GetId(), Reason: "Off topic"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rejected.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_REJECTED))
+			Expect(rejected.GetRejectionReason()).To(Equal("Off topic"))
+
+			_, err = service.GetEvent(ben, &v1.GetEventRequest{Id: submitted.GetId()})
+			Expect(store.IsNotFound(err)).To(BeTrue())
+
+			entries, err := memoryStore.ListAuditEntries(submitted.GetId())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Action).To(Equal(store.AuditActionEventRejected))
+			Expect(entries[0].Reason).To(Equal("Off topic"))
+		})
+
+		It("should be pending again when its owner resubmits it", func() {
+			_, err := service.RejectEvent(admin, &v1.RejectEventRequest{Id: submitted.GetId(), Reason: "Off topic"})
+			Expect(err).ToNot(HaveOccurred())
+
+			submitted.Status = v1.EventStatus_EVENT_STATUS_SCHEDULED
+			updated, err := service.UpdateEvent(anna, &v1.UpdateEventRequest{Id: submitted.GetId(), Event: submitted})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_PENDING))
+			Expect(updated.GetRejectionReason()).To(BeEmpty())
+		})
+	})
+})
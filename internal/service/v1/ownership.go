@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+)
+
+// eventOwners resolves the owners of events. An event is owned by the user who created it
+// and by the users of its host and co-host profiles.
+type eventOwners struct {
+	store store.Store
+}
+
+// ResolveOwners returns the IDs of the users who own the event or occurrence with the given ID.
+func (o *eventOwners) ResolveOwners(ctx context.Context, eventID string) ([]string, error) {
+	event, err := o.store.GetEventByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventOwnerIDs(o.store, event)
+}
+
+// eventOwnerIDs returns the IDs of the users who own the event.
+func eventOwnerIDs(eventStore store.Store, event *store.Event) ([]string, error) {
+	var owners []string
+	if event.OwnerID != "" {
+		owners = append(owners, event.OwnerID)
+	}
+	for _, id := range hostIDs(event) {
+		host, err := eventStore.GetHostByID(id)
+		if store.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, host.UserID)
+	}
+
+	return owners, nil
+}
+
+// assertEventOwner returns an error unless the user of the request owns the event or is an admin.
+func (s *CouchConnectionsService) assertEventOwner(ctx context.Context, eventID, message string) error {
+	if auth.GetUserInfoFromContext(ctx) == nil && !s.isAdmin(ctx) {
+		return twirp.NewError(twirp.Unauthenticated, message)
+	}
+	if s.ownership == nil {
+		return twirp.NewError(twirp.PermissionDenied, message)
+	}
+
+	err := s.ownership.AssertResourceOwnerOrCapabilityAdmin(ctx, eventID)
+	if _, ok := err.(*auth.OwnershipError); ok {
+		return twirp.NewError(twirp.PermissionDenied, message)
+	}
+	if err != nil {
+		return storeError(err)
+	}
+
+	return nil
+}
+
+// isEventOwner returns true if the user of the request owns the event or is an admin.
+// It uses the same owners as assertEventOwner, but for an event that is already loaded.
+func (s *CouchConnectionsService) isEventOwner(ctx context.Context, event *store.Event) (bool, error) {
+	caller, err := s.resolveEventCaller(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return caller.owns(event), nil
+}
+
+// eventCaller is the user of a request together with their host profile.
+// It is resolved once per request, so that the owners of many events can be checked without loading their hosts.
+type eventCaller struct {
+	admin  bool
+	userID string
+	hostID string
+}
+
+// resolveEventCaller returns the user of the request with the ID of their host profile.
+func (s *CouchConnectionsService) resolveEventCaller(ctx context.Context) (*eventCaller, error) {
+	if s.isAdmin(ctx) {
+		return &eventCaller{admin: true}, nil
+	}
+	user := auth.GetUserInfoFromContext(ctx)
+	if s.authorizer == nil || user == nil || user.Sub == "" {
+		return &eventCaller{}, nil
+	}
+
+	caller := &eventCaller{userID: user.Sub}
+	host, err := s.store.GetHostByUserID(user.Sub)
+	if store.IsNotFound(err) {
+		return caller, nil
+	}
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	caller.hostID = host.ID
+
+	return caller, nil
+}
+
+// owns returns true if the caller created the event, hosts or co-hosts it, or is an admin.
+func (c *eventCaller) owns(event *store.Event) bool {
+	if c.admin {
+		return true
+	}
+	if c.userID == "" {
+		return false
+	}
+	if event.OwnerID == c.userID {
+		return true
+	}
+	if c.hostID == "" {
+		return false
+	}
+
+	for _, id := range hostIDs(event) {
+		if id == c.hostID {
+			return true
+		}
+	}
+	return false
+}
+
+// listVisibleRegistrations returns the registrations for the event that the user of the request can see.
+// Owners and admins see all registrations, other users only their own, to not reveal who else attends.
+func (s *CouchConnectionsService) listVisibleRegistrations(ctx context.Context, event *store.Event) ([]store.Registration, error) {
+	owner, err := s.isEventOwner(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if owner {
+		registrations, err := s.store.ListRegistrations(event.ID)
+		if err != nil {
+			return nil, storeError(err)
+		}
+		return registrations, nil
+	}
+
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "listing registrations requires an authenticated user")
+	}
+	registration, err := s.store.GetRegistration(event.ID, user.Sub)
+	if store.IsNotFound(err) {
+		return nil, twirp.NewError(twirp.PermissionDenied, "only the host, co-hosts and admins can list all registrations")
+	}
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return []store.Registration{*registration}, nil
+}
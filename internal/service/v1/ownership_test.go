@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+// hostLookupCountingStore counts the host profiles that are looked up by their ID.
+type hostLookupCountingStore struct {
+	*store.MemoryStore
+	hostLookups int
+}
+
+func (s *hostLookupCountingStore) GetHostByID(id string) (*store.Host, error) {
+	s.hostLookups++
+	return s.MemoryStore.GetHostByID(id)
+}
+
+var _ = Describe("Event ownership", func() {
+	var service *CouchConnectionsService
+	var memoryStore *hostLookupCountingStore
+	var users map[string]context.Context
+	var created *v1.Event
+
+	user := func(sub string) context.Context {
+		return auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: sub, Name: sub})
+	}
+
+	saveProfile := func(ctx context.Context) *v1.Host {
+		host, err := service.UpdateMyHostProfile(ctx, &v1.UpdateMyHostProfileRequest{Host: &v1.Host{}})
+		Expect(err).ToNot(HaveOccurred())
+		return host
+	}
+
+	// expectCode fails unless the error is a twirp error with the code, or nil if the code is NoError.
+	expectCode := func(err error, code twirp.ErrorCode) {
+		if code == twirp.NoError {
+			Expect(err).ToNot(HaveOccurred())
+			return
+		}
+		twerr, ok := err.(twirp.Error)
+		Expect(ok).To(BeTrue())
+		Expect(twerr.Code()).To(Equal(code))
+	}
+
+	BeforeEach(func() {
+		memoryStore = &hostLookupCountingStore{MemoryStore: store.NewMemoryStore()}
+		service = NewCouchConnectionsService(memoryStore, 15*time.Minute, newTestAuthorizer())
+		users = map[string]context.Context{
+			"owner":     user("owner"),
+			"host":      user("host"),
+			"co-host":   user("co-host"),
+			"stranger":  user("stranger"),
+			"admin":     withAdmin(user("admin")),
+			"anonymous": context.Background(),
+		}
+		host := saveProfile(users["host"])
+		coHost := saveProfile(users["co-host"])
+
+		start, _ := ptypes.TimestampProto(time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC))
+		var err error
+		created, err = service.CreateEvent(withAdmin(users["owner"]), &v1.CreateEventRequest{Event: &v1.Event{
+			Topic:     "How viruses spread",
+			ZoomLink:  "https://zoom.us/j/123456789",
+			Start:     start,
+			Duration:  ptypes.DurationProto(time.Hour),
+			HostId:    host.GetId(),
+			CoHostIds: []string{coHost.GetId()},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("when an event is updated",
+		func(name string, code twirp.ErrorCode) {
+			created.Topic = "How viruses spread, part 1"
+
+			_, err := service.UpdateEvent(users[name], &v1.UpdateEventRequest{Id: created.GetId(), Event: created})
+
+			expectCode(err, code)
+		},
+		Entry("by its owner", "owner", twirp.NoError),
+		Entry("by its host", "host", twirp.NoError),
+		Entry("by a co-host", "co-host", twirp.NoError),
+		Entry("by an admin", "admin", twirp.NoError),
+		Entry("by another user", "stranger", twirp.PermissionDenied),
+		Entry("by an anonymous user", "anonymous", twirp.Unauthenticated),
+	)
+
+	DescribeTable("when an event is deleted",
+		func(name string, code twirp.ErrorCode) {
+			_, err := service.DeleteEvent(users[name], &v1.DeleteEventRequest{Id: created.GetId()})
+
+			expectCode(err, code)
+		},
+		Entry("by its owner", "owner", twirp.NoError),
+		Entry("by a co-host", "co-host", twirp.NoError),
+		Entry("by an admin", "admin", twirp.NoError),
+		Entry("by another user", "stranger", twirp.PermissionDenied),
+		Entry("by an anonymous user", "anonymous", twirp.Unauthenticated),
+	)
+
+	DescribeTable("when the registrations of an event are listed",
+		func(name string, code twirp.ErrorCode) {
+			_, err := service.ListRegistrations(users[name], &v1.ListRegistrationsRequest{EventId: created.GetId()})
+
+			expectCode(err, code)
+		},
+		Entry("by its owner", "owner", twirp.NoError),
+		Entry("by its host", "host", twirp.NoError),
+		Entry("by a co-host", "co-host", twirp.NoError),
+		Entry("by an admin", "admin", twirp.NoError),
+		Entry("by another user", "stranger", twirp.PermissionDenied),
+		Entry("by an anonymous user", "anonymous", twirp.Unauthenticated),
+	)
+
+	DescribeTable("when the join link of an event is requested",
+		func(name string, code twirp.ErrorCode) {
+			link, err := service.GetJoinLink(users[name], &v1.GetJoinLinkRequest{Id: created.GetId()})
+
+			expectCode(err, code)
+			if code == twirp.NoError {
+				Expect(link.GetZoomLink()).To(Equal("https://zoom.us/j/123456789"))
+			}
+		},
+		Entry("by its owner", "owner", twirp.NoError),
+		Entry("by its host", "host", twirp.NoError),
+		Entry("by a co-host", "co-host", twirp.NoError),
+		Entry("by an admin", "admin", twirp.NoError),
+		Entry("by another user", "stranger", twirp.PermissionDenied),
+		Entry("by an anonymous user", "anonymous", twirp.Unauthenticated),
+	)
+
+	DescribeTable("when an event is read",
+		func(name string, visible bool) {
+			event, err := service.GetEvent(users[name], &v1.GetEventRequest{Id: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(event.GetZoomLink() != "").To(Equal(visible))
+		},
+		Entry("by its owner", "owner", true),
+		Entry("by its host", "host", true),
+		Entry("by a co-host", "co-host", true),
+		Entry("by an admin", "admin", true),
+		Entry("by another user", "stranger", false),
+		Entry("by an anonymous user", "anonymous", false),
+	)
+
+	Describe("when events are listed by a co-host", func() {
+		It("should show the join link without looking up the hosts of each event", func() {
+			memoryStore.hostLookups = 0
+
+			resp, err := service.ListEvents(users["co-host"], &v1.ListEventsRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(HaveLen(1))
+			Expect(resp.GetEvents()[0].GetZoomLink()).To(Equal("https://zoom.us/j/123456789"))
+			Expect(memoryStore.hostLookups).To(BeZero())
+		})
+	})
+
+	Describe("when the registrations of an unpublished event are listed", func() {
+		It("should return a not found error to other users", func() {
+			draft, err := service.CreateEvent(users["owner"], &v1.CreateEventRequest{Event: &v1.Event{
+				Topic:    "How viruses spread, draft",
+				Start:    created.GetStart(),
+				Duration: ptypes.DurationProto(time.Hour),
+				Status:   v1.EventStatus_EVENT_STATUS_DRAFT,
+			}})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.ListRegistrations(users["stranger"], &v1.ListRegistrationsRequest{EventId: draft.GetId()})
+
+			Expect(store.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("when an event does not exist", func() {
+		It("should return a not found error to other users", func() {
+			_, err := service.DeleteEvent(users["stranger"], &v1.DeleteEventRequest{Id: "unknown"})
+
+			Expect(store.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
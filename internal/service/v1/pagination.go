@@ -0,0 +1,79 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+// defaultPageSize is the number of events returned if no page size is requested.
+const defaultPageSize = 100
+
+// eventQueryFromProto returns the store query for a list events request.
+func eventQueryFromProto(req *v1.ListEventsRequest) (*store.EventQuery, error) {
+	query := &store.EventQuery{
+		Host:       req.GetHost(),
+		Language:   req.GetLanguage(),
+		Tag:        normalize(req.GetTag()),
+		CategoryID: req.GetCategoryId(),
+		Limit:      int(req.GetPageSize()),
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultPageSize
+	}
+
+	var err error
+	if req.GetFrom() != nil {
+		if query.From, err = ptypes.Timestamp(req.GetFrom()); err != nil {
+			return nil, twirp.InvalidArgumentError("from", err.Error())
+		}
+	}
+	if req.GetTo() != nil {
+		if query.To, err = ptypes.Timestamp(req.GetTo()); err != nil {
+			return nil, twirp.InvalidArgumentError("to", err.Error())
+		}
+	}
+	if req.GetStatus() != v1.EventStatus_EVENT_STATUS_UNSPECIFIED {
+		query.Statuses = []store.EventStatus{eventStatusFromProto[req.GetStatus()]}
+	}
+	if req.GetOrderBy() == v1.EventOrder_EVENT_ORDER_START_DESCENDING {
+		query.Order = store.SortDescending
+	}
+	if req.GetPageToken() != "" {
+		if query.After, err = decodePageToken(req.GetPageToken()); err != nil {
+			return nil, twirp.InvalidArgumentError("page_token", "must be the next_page_token of a previous response")
+		}
+	}
+
+	return query, nil
+}
+
+// encodePageToken returns an opaque page token for the cursor.
+func encodePageToken(cursor *store.EventCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor.Start.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID))
+}
+
+// decodePageToken returns the cursor of a page token created by encodePageToken.
+func decodePageToken(token string) (*store.EventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(data), "|", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("page token has no ID")
+	}
+	start, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &store.EventCursor{Start: start, ID: parts[1]}, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"github.com/sebastianrosch/couchconnections/internal/search"
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+const (
+	// defaultSearchPageSize is the number of search results returned if no page size is requested.
+	defaultSearchPageSize = 20
+	// snippetSize is the maximum length of the text in a highlight.
+	snippetSize = 160
+)
+
+// highlights returns the parts of the searchable fields of an event that contain the search terms.
+func highlights(event *store.Event, terms []string) []*v1.Highlight {
+	fields := []struct {
+		name string
+		text string
+	}{
+		{"topic", event.Topic},
+		{"host", event.Host},
+		{"description", event.Description},
+	}
+
+	var results []*v1.Highlight
+	for _, field := range fields {
+		if snippet := search.Snippet(field.text, terms, snippetSize); snippet != "" {
+			results = append(results, &v1.Highlight{Field: field.name, Snippet: snippet})
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Event search", func() {
+	var service *CouchConnectionsService
+	var ctx context.Context
+
+	create := func(topic, host, description string) *v1.Event {
+		start, _ := ptypes.TimestampProto(time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC))
+		event, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: &v1.Event{
+			Topic:       topic,
+			Host:        host,
+			Description: description,
+			ZoomLink:    "https://zoom.us/j/" + topic,
+			Start:       start,
+			Duration:    ptypes.DurationProto(time.Hour),
+		}, IgnoreConflicts: true})
+		Expect(err).ToNot(HaveOccurred())
+		return event
+	}
+
+	BeforeEach(func() {
+		service = NewCouchConnectionsService(store.NewMemoryStore(), 15*time.Minute, newTestAuthorizer())
+		ctx = withAdmin(context.Background())
+		create("How viruses spread", "Anna", "What we know about Corona so far.")
+		create("Corona and FinTech", "Ben", "Payments during the crisis.")
+		create("Baking bread", "Carla", "Sourdough for beginners.")
+	})
+
+	It("should return the matching events ordered by relevance", func() {
+		resp, err := service.SearchEvents(context.Background(), &v1.SearchEventsRequest{Query: "corona"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resp.GetResults()).To(HaveLen(2))
+		Expect(resp.GetResults()[0].GetEvent().GetTopic()).To(Equal("Corona and FinTech"))
+		Expect(resp.GetResults()[0].GetScore()).To(BeNumerically(">", resp.GetResults()[1].GetScore()))
+	})
+
+	It("should highlight the search terms", func() {
+		resp, err := service.SearchEvents(context.Background(), &v1.SearchEventsRequest{Query: "Corona"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resp.GetResults()[1].GetHighlights()).To(ConsistOf(&v1.Highlight{
+			Field:   "description",
+			Snippet: "What we know about <em>Corona</em> so far.",
+		}))
+	})
+
+	It("should not return the join links", func() {
+		resp, err := service.SearchEvents(context.Background(), &v1.SearchEventsRequest{Query: "bread"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resp.GetResults()).To(HaveLen(1))
+		Expect(resp.GetResults()[0].GetEvent().GetZoomLink()).To(BeEmpty())
+	})
+
+	Describe("when unpublished events match", func() {
+		var owner context.Context
+
+		BeforeEach(func() {
+			owner = auth.WithUserInfo(context.Background(), &auth.UserInfoResponse{Sub: "owner"})
+			start, _ := ptypes.TimestampProto(time.Date(2020, 4, 2, 18, 0, 0, 0, time.UTC))
+			_, err := service.CreateEvent(owner, &v1.CreateEventRequest{Event: &v1.Event{
+				Topic:    "Corona, Corona, Corona",
+				Start:    start,
+				Duration: ptypes.DurationProto(time.Hour),
+				Status:   v1.EventStatus_EVENT_STATUS_DRAFT,
+			}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fill the page with published events", func() {
+			resp, err := service.SearchEvents(context.Background(), &v1.SearchEventsRequest{Query: "corona", PageSize: 1})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resp.GetResults()).To(HaveLen(1))
+			Expect(resp.GetResults()[0].GetEvent().GetTopic()).To(Equal("Corona and FinTech"))
+		})
+
+		It("should return them to their owner", func() {
+			resp, err := service.SearchEvents(owner, &v1.SearchEventsRequest{Query: "corona", PageSize: 1})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resp.GetResults()).To(HaveLen(1))
+			Expect(resp.GetResults()[0].GetEvent().GetTopic()).To(Equal("Corona, Corona, Corona"))
+		})
+	})
+
+	It("should reject a query without words", func() {
+		_, err := service.SearchEvents(context.Background(), &v1.SearchEventsRequest{Query: "?!"})
+
+		twerr, ok := err.(twirp.Error)
+		Expect(ok).To(BeTrue())
+		Expect(twerr.Code()).To(Equal(twirp.InvalidArgument))
+	})
+})
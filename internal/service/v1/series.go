@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/recurrence"
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+// seriesHorizon is how far series are expanded if no end of the time window is requested.
+const seriesHorizon = 365 * 24 * time.Hour
+
+// listEvents returns a page of the events and the occurrences of all series that are selected
+// by the query, and whether more events follow the page.
+func (s *CouchConnectionsService) listEvents(query store.EventQuery) ([]store.Event, bool, error) {
+	limit := query.Limit
+	if limit > 0 {
+		query.Limit = limit + 1
+	}
+	events, err := s.store.ListEvents(&query)
+	if err != nil {
+		return nil, false, twirp.InternalErrorWith(err)
+	}
+
+	horizon := query.To
+	if horizon.IsZero() {
+		if query.From.IsZero() {
+			horizon = s.now().Add(seriesHorizon)
+		} else {
+			horizon = query.From.Add(seriesHorizon)
+		}
+	}
+	occurrences, err := store.GetOccurrences(s.store, query.From, horizon)
+	if err != nil {
+		return nil, false, twirp.InternalErrorWith(err)
+	}
+	for i := range occurrences {
+		if query.Matches(&occurrences[i]) {
+			events = append(events, occurrences[i])
+		}
+	}
+
+	events, more := page(&query, events, limit)
+	return events, more, nil
+}
+
+// page orders the events as requested by the query and returns at most limit events
+// and whether more events follow the page.
+func page(query *store.EventQuery, events []store.Event, limit int) ([]store.Event, bool) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return query.Less(events[i].Start, events[i].ID, &events[j])
+	})
+	more := limit > 0 && len(events) > limit
+	if more {
+		events = events[:limit]
+	}
+
+	return events, more
+}
+
+// createSeries creates a series from an event with a recurrence rule and returns its first occurrence.
+func (s *CouchConnectionsService) createSeries(ctx context.Context, event *store.Event, ignoreConflicts bool) (*store.Event, error) {
+	series, err := newSeries(event, event.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSeriesConflicts(ctx, series, ignoreConflicts, replacesNothing); err != nil {
+		return nil, err
+	}
+
+	series, err = s.store.CreateSeries(series)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return occurrence(series, series.Event.Start)
+}
+
+// updateOccurrence updates a single occurrence of a series or the occurrence and all following occurrences.
+func (s *CouchConnectionsService) updateOccurrence(ctx context.Context, seriesID string, originalStart time.Time, event *store.Event, scope v1.RecurrenceScope, ignoreConflicts bool) (*store.Event, error) {
+	series, err := s.getSeries(seriesID, originalStart)
+	if err != nil {
+		return nil, err
+	}
+	event.ID = ""
+	event.SeriesID = ""
+	event.OwnerID = series.Event.OwnerID
+
+	if scope != v1.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING {
+		event.Recurrence = ""
+		replaced := replacesEvent(store.OccurrenceID(series.ID, originalStart))
+		if err := s.checkConflicts(ctx, []store.Event{*event}, ignoreConflicts, replaced); err != nil {
+			return nil, err
+		}
+		series.SetException(store.SeriesException{OriginalStart: originalStart, Event: event})
+		if _, err := s.store.UpdateSeries(series); err != nil {
+			return nil, storeError(err)
+		}
+		return occurrence(series, originalStart)
+	}
+
+	rule := event.Recurrence
+	if originalStart.Equal(series.Event.Start) {
+		// Updating the first and all following occurrences updates the whole series.
+		if rule == "" {
+			rule = series.Recurrence
+		}
+		updated, err := newSeries(event, rule)
+		if err != nil {
+			return nil, err
+		}
+		updated.ID = series.ID
+		updated.Exceptions = keepValidExceptions(series.Exceptions, updated)
+		if err := s.checkSeriesConflicts(ctx, updated, ignoreConflicts, replacesSeries(series.ID, time.Time{})); err != nil {
+			return nil, err
+		}
+		if _, err := s.store.UpdateSeries(updated); err != nil {
+			return nil, storeError(err)
+		}
+		return occurrence(updated, updated.Event.Start)
+	}
+
+	following, err := splitSeries(series, originalStart)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	if rule == "" {
+		rule = following
+	}
+	next, err := newSeries(event, rule)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSeriesConflicts(ctx, next, ignoreConflicts, replacesSeries(series.ID, originalStart)); err != nil {
+		return nil, err
+	}
+	if _, err := s.store.UpdateSeries(series); err != nil {
+		return nil, storeError(err)
+	}
+	next, err = s.store.CreateSeries(next)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return occurrence(next, next.Event.Start)
+}
+
+// checkSeriesConflicts checks the occurrences of a series within the series horizon for conflicts.
+func (s *CouchConnectionsService) checkSeriesConflicts(ctx context.Context, series *store.Series, ignoreConflicts bool, replaced func(*store.Event) bool) error {
+	occurrences, err := series.Occurrences(series.Event.Start, series.Event.Start.Add(seriesHorizon))
+	if err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+	return s.checkConflicts(ctx, occurrences, ignoreConflicts, replaced)
+}
+
+// deleteOccurrence cancels a single occurrence of a series or deletes the occurrence and all following occurrences.
+func (s *CouchConnectionsService) deleteOccurrence(seriesID string, originalStart time.Time, scope v1.RecurrenceScope) error {
+	series, err := s.getSeries(seriesID, originalStart)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case scope != v1.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING:
+		exception := store.SeriesException{OriginalStart: originalStart, Cancelled: true}
+		for _, existing := range series.Exceptions {
+			if existing.OriginalStart.Equal(originalStart) {
+				exception.Event = existing.Event
+			}
+		}
+		series.SetException(exception)
+	case originalStart.Equal(series.Event.Start):
+		if err := s.store.DeleteSeries(series.ID); err != nil {
+			return storeError(err)
+		}
+		return nil
+	default:
+		if _, err := splitSeries(series, originalStart); err != nil {
+			return twirp.InternalErrorWith(err)
+		}
+	}
+
+	if _, err := s.store.UpdateSeries(series); err != nil {
+		return storeError(err)
+	}
+	return nil
+}
+
+// getSeries returns the series of an occurrence or a NotFoundError if the series or the occurrence doesn't exist.
+func (s *CouchConnectionsService) getSeries(seriesID string, originalStart time.Time) (*store.Series, error) {
+	series, err := s.store.GetSeriesByID(seriesID)
+	if store.IsNotFound(err) {
+		return nil, store.NewNotFoundError("event", store.OccurrenceID(seriesID, originalStart))
+	}
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	if _, err := series.Occurrence(originalStart); err != nil {
+		return nil, storeError(err)
+	}
+
+	return series, nil
+}
+
+// newSeries returns a new series with the event as template. The start of the template is moved
+// to the first occurrence, which is the first time at or after the start of the event that matches the rule.
+func newSeries(event *store.Event, rule string) (*store.Series, error) {
+	parsed, err := recurrence.Parse(rule)
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("recurrence", "must be a valid recurrence rule: "+err.Error())
+	}
+
+	series := &store.Series{Event: *event, Recurrence: parsed.String()}
+	series.Event.ID = ""
+	series.Event.SeriesID = ""
+	series.Event.Recurrence = ""
+
+	var first time.Time
+	parsed.Iterate(series.Start(), func(t time.Time) bool {
+		first = t
+		return false
+	})
+	if first.IsZero() {
+		return nil, twirp.InvalidArgumentError("recurrence", "has no occurrences")
+	}
+	duration := event.Duration()
+	series.Event.Start = first
+	series.Event.End = first.Add(duration)
+
+	return series, nil
+}
+
+// splitSeries ends the series before the occurrence with the given original start time and
+// drops the exceptions of the following occurrences. It returns the recurrence rule of the
+// following occurrences, which continues the series.
+func splitSeries(series *store.Series, originalStart time.Time) (string, error) {
+	rule, err := series.Rule()
+	if err != nil {
+		return "", err
+	}
+	following := *rule
+
+	if rule.Count > 0 {
+		before := len(rule.Between(series.Start(), series.Start(), originalStart))
+		rule.Count = before
+		following.Count -= before
+	} else {
+		rule.Until = originalStart.Add(-time.Second).UTC()
+	}
+	series.Recurrence = rule.String()
+
+	exceptions := series.Exceptions[:0]
+	for _, exception := range series.Exceptions {
+		if exception.OriginalStart.Before(originalStart) {
+			exceptions = append(exceptions, exception)
+		}
+	}
+	series.Exceptions = exceptions
+
+	return following.String(), nil
+}
+
+// keepValidExceptions returns the exceptions that still match an occurrence of the series.
+func keepValidExceptions(exceptions []store.SeriesException, series *store.Series) []store.SeriesException {
+	rule, err := series.Rule()
+	if err != nil {
+		return nil
+	}
+
+	var results []store.SeriesException
+	for _, exception := range exceptions {
+		if rule.Includes(series.Start(), exception.OriginalStart) {
+			results = append(results, exception)
+		}
+	}
+	return results
+}
+
+// occurrence returns the occurrence of a series with the given original start time.
+func occurrence(series *store.Series, originalStart time.Time) (*store.Event, error) {
+	event, err := series.Occurrence(originalStart)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return event, nil
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("Event series", func() {
+	var service *CouchConnectionsService
+	var ctx context.Context
+	var start time.Time
+	var first *v1.Event
+
+	protoTime := func(t time.Time) *timestamp.Timestamp {
+		ts, _ := ptypes.TimestampProto(t)
+		return ts
+	}
+
+	list := func(from, to time.Time) []*v1.Event {
+		resp, err := service.ListEvents(ctx, &v1.ListEventsRequest{From: protoTime(from), To: protoTime(to)})
+		Expect(err).ToNot(HaveOccurred())
+		return resp.GetEvents()
+	}
+
+	topics := func(events []*v1.Event) []string {
+		results := make([]string, len(events))
+		for i, event := range events {
+			results[i] = event.GetTopic()
+		}
+		return results
+	}
+
+	BeforeEach(func() {
+		service = NewCouchConnectionsService(store.NewMemoryStore(), 15*time.Minute, newTestAuthorizer())
+		ctx = withAdmin(context.Background())
+		// A Monday, the rule moves the first occurrence to Wednesday.
+		start = time.Date(2020, 3, 30, 19, 0, 0, 0, time.UTC)
+
+		event := &v1.Event{
+			Topic:      "Remote Arbeiten",
+			Start:      protoTime(start),
+			Duration:   ptypes.DurationProto(time.Hour),
+			TimeZone:   "Europe/Berlin",
+			Recurrence: "FREQ=WEEKLY;BYDAY=WE;COUNT=4",
+		}
+
+		var err error
+		first, err = service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("when a series is created", func() {
+		It("should return the first occurrence", func() {
+			firstStart, _ := ptypes.Timestamp(first.GetStart())
+
+			Expect(firstStart).To(Equal(time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC)))
+			Expect(first.GetSeriesId()).ToNot(BeEmpty())
+			Expect(first.GetRecurrence()).To(Equal("FREQ=WEEKLY;COUNT=4;BYDAY=WE"))
+		})
+
+		It("should be expanded into its occurrences when events are listed", func() {
+			events := list(start, start.AddDate(0, 1, 0))
+
+			Expect(events).To(HaveLen(4))
+			Expect(events[3].GetId()).To(Equal(first.GetSeriesId() + "_20200422T190000Z"))
+		})
+
+		It("should reject invalid rules", func() {
+			event := &v1.Event{
+				Topic:      "Remote Arbeiten",
+				Start:      protoTime(start),
+				Duration:   ptypes.DurationProto(time.Hour),
+				Recurrence: "FREQ=SOMETIMES",
+			}
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("when a single occurrence is changed", func() {
+		It("should only move that occurrence", func() {
+			second := list(start, start.AddDate(0, 1, 0))[1]
+			moved := &v1.Event{
+				Topic:    "Remote Arbeiten (Thursday)",
+				Start:    protoTime(time.Date(2020, 4, 9, 19, 0, 0, 0, time.UTC)),
+				Duration: ptypes.DurationProto(time.Hour),
+			}
+
+			updated, err := service.UpdateEvent(ctx, &v1.UpdateEventRequest{Id: second.GetId(), Event: moved})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetId()).To(Equal(second.GetId()))
+
+			Expect(topics(list(start, start.AddDate(0, 1, 0)))).To(Equal([]string{
+				"Remote Arbeiten", "Remote Arbeiten (Thursday)", "Remote Arbeiten", "Remote Arbeiten",
+			}))
+		})
+
+		It("should cancel that occurrence when it is deleted", func() {
+			second := list(start, start.AddDate(0, 1, 0))[1]
+
+			_, err := service.DeleteEvent(ctx, &v1.DeleteEventRequest{Id: second.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			event, err := service.GetEvent(ctx, &v1.GetEventRequest{Id: second.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_CANCELLED))
+		})
+	})
+
+	Describe("when an occurrence and all following occurrences are changed", func() {
+		It("should split the series", func() {
+			third := list(start, start.AddDate(0, 1, 0))[2]
+			changed := &v1.Event{
+				Start:    third.GetStart(),
+				Duration: ptypes.DurationProto(2 * time.Hour),
+				Topic:    "Remote Arbeiten, extended",
+				TimeZone: "Europe/Berlin",
+			}
+
+			updated, err := service.UpdateEvent(ctx, &v1.UpdateEventRequest{
+				Id:    third.GetId(),
+				Event: changed,
+				Scope: v1.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetSeriesId()).ToNot(Equal(first.GetSeriesId()))
+			Expect(updated.GetRecurrence()).To(Equal("FREQ=WEEKLY;COUNT=2;BYDAY=WE"))
+
+			Expect(topics(list(start, start.AddDate(0, 2, 0)))).To(Equal([]string{
+				"Remote Arbeiten", "Remote Arbeiten", "Remote Arbeiten, extended", "Remote Arbeiten, extended",
+			}))
+		})
+
+		It("should end the series when they are deleted", func() {
+			third := list(start, start.AddDate(0, 1, 0))[2]
+
+			_, err := service.DeleteEvent(ctx, &v1.DeleteEventRequest{
+				Id:    third.GetId(),
+				Scope: v1.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(list(start, start.AddDate(0, 2, 0))).To(HaveLen(2))
+		})
+	})
+
+	Describe("when events are listed in pages", func() {
+		It("should return the events and occurrences of all pages in order", func() {
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: &v1.Event{
+				Topic:    "Baking bread",
+				Start:    protoTime(time.Date(2020, 4, 9, 12, 0, 0, 0, time.UTC)),
+				Duration: ptypes.DurationProto(time.Hour),
+				TimeZone: "Europe/Berlin",
+			}})
+			Expect(err).ToNot(HaveOccurred())
+
+			var pages [][]string
+			req := &v1.ListEventsRequest{From: protoTime(start), To: protoTime(start.AddDate(0, 2, 0)), PageSize: 2}
+			for {
+				resp, err := service.ListEvents(ctx, req)
+				Expect(err).ToNot(HaveOccurred())
+				pages = append(pages, topics(resp.GetEvents()))
+				if resp.GetNextPageToken() == "" {
+					break
+				}
+				req.PageToken = resp.GetNextPageToken()
+			}
+
+			Expect(pages).To(Equal([][]string{
+				{"Remote Arbeiten", "Remote Arbeiten"},
+				{"Baking bread", "Remote Arbeiten"},
+				{"Remote Arbeiten"},
+			}))
+		})
+
+		It("should return the events in descending order", func() {
+			resp, err := service.ListEvents(ctx, &v1.ListEventsRequest{
+				From:     protoTime(start),
+				To:       protoTime(start.AddDate(0, 2, 0)),
+				PageSize: 1,
+				OrderBy:  v1.EventOrder_EVENT_ORDER_START_DESCENDING,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			last, _ := ptypes.Timestamp(resp.GetEvents()[0].GetStart())
+			Expect(last).To(Equal(time.Date(2020, 4, 22, 19, 0, 0, 0, time.UTC)))
+			Expect(resp.GetNextPageToken()).ToNot(BeEmpty())
+		})
+
+		It("should reject an invalid page token", func() {
+			_, err := service.ListEvents(ctx, &v1.ListEventsRequest{PageToken: "invalid"})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
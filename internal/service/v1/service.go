@@ -2,13 +2,45 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/calendar"
+	"github.com/sebastianrosch/couchconnections/internal/search"
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
 	buildinfo "github.com/sebastianrosch/couchconnections/pkg/build-info"
 	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
 )
 
+// CouchConnectionsService implements the CouchConnections gRPC service.
 type CouchConnectionsService struct {
+	store          store.Store
+	joinLinkWindow time.Duration
+	authorizer     *auth.Authorizer
+	ownership      *auth.OwnershipAuthorizer
+	now            func() time.Time
+}
+
+// NewCouchConnectionsService returns a new CouchConnectionsService backed by the given store.
+// The join link of an event is revealed to confirmed attendees from joinLinkWindow before its start until its end.
+// The authorizer decides which users are admins. Only admins and the owners of an event can change it.
+func NewCouchConnectionsService(store store.Store, joinLinkWindow time.Duration, authorizer *auth.Authorizer) *CouchConnectionsService {
+	service := &CouchConnectionsService{
+		store:          store,
+		joinLinkWindow: joinLinkWindow,
+		authorizer:     authorizer,
+		now:            time.Now,
+	}
+	if authorizer != nil {
+		service.ownership = auth.NewOwnershipAuthorizer(authorizer, &eventOwners{store: store})
+	}
+
+	return service
 }
 
 // ------------------
@@ -25,3 +57,701 @@ func (s *CouchConnectionsService) GetVersion(ctx context.Context, req *empty.Emp
 		Revision: buildInfo.Revision,
 	}, nil
 }
+
+// ----------------
+// Event endpoints.
+// ----------------
+
+// CreateEvent creates a new event.
+func (s *CouchConnectionsService) CreateEvent(ctx context.Context, req *v1.CreateEventRequest) (*v1.Event, error) {
+	event, err := EventFromProto(req.GetEvent())
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("event", err.Error())
+	}
+	if err := validateEvent(event); err != nil {
+		return nil, err
+	}
+	if err := s.validateCategory(event); err != nil {
+		return nil, err
+	}
+	if err := s.resolveHosts(event); err != nil {
+		return nil, err
+	}
+
+	event.ID = ""
+	event.OwnerID = ""
+	event.SeriesID = ""
+	event.RejectionReason = ""
+	event.Status = s.moderatedStatus(ctx, event.Status, nil)
+	if user := auth.GetUserInfoFromContext(ctx); user != nil {
+		event.OwnerID = user.Sub
+	}
+	if event.Recurrence != "" {
+		event, err = s.createSeries(ctx, event, req.GetIgnoreConflicts())
+		if err != nil {
+			return nil, err
+		}
+		return eventToProto(event)
+	}
+	if err := s.checkConflicts(ctx, []store.Event{*event}, req.GetIgnoreConflicts(), replacesNothing); err != nil {
+		return nil, err
+	}
+
+	event, err = s.store.CreateEvent(event)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return eventToProto(event)
+}
+
+// GetEvent returns a single event.
+func (s *CouchConnectionsService) GetEvent(ctx context.Context, req *v1.GetEventRequest) (*v1.Event, error) {
+	event, err := s.getVisibleEvent(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	caller, err := s.resolveEventCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.redactJoinLink(caller, event); err != nil {
+		return nil, err
+	}
+
+	return eventToProto(event)
+}
+
+// ListEvents returns a page of the events that match the filters of the request.
+// Series are expanded into their occurrences within the requested time window.
+func (s *CouchConnectionsService) ListEvents(ctx context.Context, req *v1.ListEventsRequest) (*v1.ListEventsResponse, error) {
+	query, err := eventQueryFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	query.Published = !s.isAdmin(ctx)
+	if user := auth.GetUserInfoFromContext(ctx); user != nil {
+		query.OwnerID = user.Sub
+	}
+
+	events, more, err := s.listEvents(*query)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := s.resolveEventCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListEventsResponse{
+		Events: make([]*v1.Event, 0, len(events)),
+	}
+	for i := range events {
+		if err := s.redactJoinLink(caller, &events[i]); err != nil {
+			return nil, err
+		}
+		event, err := eventToProto(&events[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Events = append(resp.Events, event)
+	}
+	if more {
+		resp.NextPageToken = encodePageToken(events[len(events)-1].Cursor())
+	}
+
+	return resp, nil
+}
+
+// UpdateEvent updates an existing event.
+// For occurrences of a series, the scope selects the occurrences that are updated.
+func (s *CouchConnectionsService) UpdateEvent(ctx context.Context, req *v1.UpdateEventRequest) (*v1.Event, error) {
+	event, err := EventFromProto(req.GetEvent())
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("event", err.Error())
+	}
+	if err := validateEvent(event); err != nil {
+		return nil, err
+	}
+	if err := s.validateCategory(event); err != nil {
+		return nil, err
+	}
+	if err := s.resolveHosts(event); err != nil {
+		return nil, err
+	}
+	existing, err := s.getVisibleEvent(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.assertEventOwner(ctx, req.GetId(), "only the host, co-hosts and admins can update the event"); err != nil {
+		return nil, err
+	}
+	event.Status = s.moderatedStatus(ctx, event.Status, existing)
+	event.RejectionReason = ""
+	if event.Status == store.EventStatusRejected {
+		event.RejectionReason = existing.RejectionReason
+	}
+
+	if seriesID, originalStart, ok := store.ParseOccurrenceID(req.GetId()); ok {
+		event, err = s.updateOccurrence(ctx, seriesID, originalStart, event, req.GetScope(), req.GetIgnoreConflicts())
+		if err != nil {
+			return nil, err
+		}
+		if err := s.fillAddedSeats(existing, event); err != nil {
+			return nil, err
+		}
+		return eventToProto(event)
+	}
+
+	if event.Recurrence != "" {
+		return nil, twirp.InvalidArgumentError("recurrence", "can only be set when an event is created")
+	}
+	event.ID = existing.ID
+	event.OwnerID = existing.OwnerID
+	event.SeriesID = ""
+	if err := s.checkConflicts(ctx, []store.Event{*event}, req.GetIgnoreConflicts(), replacesEvent(event.ID)); err != nil {
+		return nil, err
+	}
+
+	event, err = s.store.UpdateEvent(event)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if err := s.fillAddedSeats(existing, event); err != nil {
+		return nil, err
+	}
+
+	return eventToProto(event)
+}
+
+// DeleteEvent deletes an event.
+// For occurrences of a series, the scope selects the occurrences that are deleted.
+func (s *CouchConnectionsService) DeleteEvent(ctx context.Context, req *v1.DeleteEventRequest) (*empty.Empty, error) {
+	if err := s.assertEventOwner(ctx, req.GetId(), "only the host, co-hosts and admins can delete the event"); err != nil {
+		return nil, err
+	}
+
+	if seriesID, originalStart, ok := store.ParseOccurrenceID(req.GetId()); ok {
+		if err := s.deleteOccurrence(seriesID, originalStart, req.GetScope()); err != nil {
+			return nil, err
+		}
+		return &empty.Empty{}, nil
+	}
+
+	err := s.store.DeleteEvent(req.GetId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// SearchEvents returns the events that match a search query, ordered by relevance.
+func (s *CouchConnectionsService) SearchEvents(ctx context.Context, req *v1.SearchEventsRequest) (*v1.SearchEventsResponse, error) {
+	terms := search.Terms(req.GetQuery())
+	if len(terms) == 0 {
+		return nil, twirp.InvalidArgumentError("query", "must contain at least one word")
+	}
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = defaultSearchPageSize
+	}
+
+	// The visibility is checked by the store, so that invisible events don't take up the limit.
+	query := &store.SearchQuery{
+		Text:      req.GetQuery(),
+		Published: !s.isAdmin(ctx),
+		Limit:     limit,
+	}
+	if user := auth.GetUserInfoFromContext(ctx); user != nil {
+		query.OwnerID = user.Sub
+	}
+	results, err := s.store.SearchEvents(query)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	caller, err := s.resolveEventCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.SearchEventsResponse{
+		Results: make([]*v1.SearchResult, 0, len(results)),
+	}
+	for i := range results {
+		if err := s.redactJoinLink(caller, &results[i].Event); err != nil {
+			return nil, err
+		}
+		event, err := eventToProto(&results[i].Event)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, &v1.SearchResult{
+			Event:      event,
+			Score:      results[i].Score,
+			Highlights: highlights(&results[i].Event, terms),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetJoinLink returns the join link of an event to its owners, admins and confirmed attendees.
+// Every successful access is recorded in the audit log.
+func (s *CouchConnectionsService) GetJoinLink(ctx context.Context, req *v1.GetJoinLinkRequest) (*v1.JoinLink, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "getting the join link requires an authenticated user")
+	}
+
+	event, err := s.getVisibleEvent(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if event.ZoomLink == "" {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "event has no join link")
+	}
+
+	owner, err := s.isEventOwner(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if !owner {
+		registered, err := s.isConfirmedAttendee(event.ID, user.Sub)
+		if err != nil {
+			return nil, err
+		}
+		if !registered {
+			return nil, twirp.NewError(twirp.PermissionDenied, "only the host, co-hosts and confirmed attendees can get the join link")
+		}
+		if !s.isWithinJoinLinkWindow(event) {
+			return nil, twirp.NewError(twirp.FailedPrecondition, "join link is not available yet").
+				WithMeta("available_from", event.Start.Add(-s.joinLinkWindow).UTC().Format(time.RFC3339))
+		}
+	}
+
+	if err := s.store.RecordAuditEntry(&store.AuditEntry{
+		EventID:   event.ID,
+		UserID:    user.Sub,
+		Action:    store.AuditActionJoinLinkAccessed,
+		CreatedAt: s.now(),
+	}); err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return &v1.JoinLink{
+		EventId:  event.ID,
+		ZoomLink: event.ZoomLink,
+	}, nil
+}
+
+// -----------------------
+// Registration endpoints.
+// -----------------------
+
+// RegisterForEvent registers the authenticated user for an event.
+func (s *CouchConnectionsService) RegisterForEvent(ctx context.Context, req *v1.RegisterForEventRequest) (*v1.Registration, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "registering for an event requires an authenticated user")
+	}
+
+	event, err := s.store.GetEventByID(req.GetEventId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if !event.IsPublished() || event.Status == store.EventStatusCancelled || event.Status == store.EventStatusFinished {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "event is "+string(event.Status))
+	}
+
+	registration, err := s.store.RegisterForEvent(&store.Registration{
+		EventID:  event.ID,
+		UserID:   user.Sub,
+		UserName: user.Name,
+	}, event.Capacity)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return registrationToProto(registration)
+}
+
+// CancelRegistration cancels the registration of the authenticated user for an event.
+func (s *CouchConnectionsService) CancelRegistration(ctx context.Context, req *v1.CancelRegistrationRequest) (*empty.Empty, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "cancelling a registration requires an authenticated user")
+	}
+
+	event, err := s.store.GetEventByID(req.GetEventId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	_, err = s.store.CancelRegistration(event.ID, user.Sub, event.Capacity)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// fillAddedSeats confirms waitlisted registrations for the seats that were added by raising the capacity of an event.
+func (s *CouchConnectionsService) fillAddedSeats(existing, updated *store.Event) error {
+	if existing.Capacity == 0 || (updated.Capacity != 0 && updated.Capacity <= existing.Capacity) {
+		return nil
+	}
+
+	if _, err := s.store.FillSeats(updated.ID, updated.Capacity); err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+	return nil
+}
+
+// ListRegistrations returns the registrations for an event.
+// The owners of the event and admins get all registrations, other users only their own.
+func (s *CouchConnectionsService) ListRegistrations(ctx context.Context, req *v1.ListRegistrationsRequest) (*v1.ListRegistrationsResponse, error) {
+	event, err := s.getVisibleEvent(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+
+	registrations, err := s.listVisibleRegistrations(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListRegistrationsResponse{
+		Registrations: make([]*v1.Registration, 0, len(registrations)),
+	}
+	for i := range registrations {
+		registration, err := registrationToProto(&registrations[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Registrations = append(resp.Registrations, registration)
+	}
+
+	return resp, nil
+}
+
+// ---------------------
+// Moderation endpoints.
+// ---------------------
+
+// ListPendingEvents returns the events that wait for review.
+func (s *CouchConnectionsService) ListPendingEvents(ctx context.Context, req *v1.ListPendingEventsRequest) (*v1.ListEventsResponse, error) {
+	if err := s.assertAdmin(ctx, "only admins can moderate events"); err != nil {
+		return nil, err
+	}
+
+	query, err := eventQueryFromProto(&v1.ListEventsRequest{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		Status:    v1.EventStatus_EVENT_STATUS_PENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, more, err := s.listPendingEvents(*query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListEventsResponse{
+		Events: make([]*v1.Event, 0, len(events)),
+	}
+	for i := range events {
+		event, err := eventToProto(&events[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Events = append(resp.Events, event)
+	}
+	if more {
+		resp.NextPageToken = encodePageToken(events[len(events)-1].Cursor())
+	}
+
+	return resp, nil
+}
+
+// ApproveEvent approves a pending event and publishes it.
+func (s *CouchConnectionsService) ApproveEvent(ctx context.Context, req *v1.ApproveEventRequest) (*v1.Event, error) {
+	event, err := s.moderate(ctx, req.GetId(), store.EventStatusScheduled, store.AuditActionEventApproved, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return eventToProto(event)
+}
+
+// RejectEvent rejects a pending event.
+func (s *CouchConnectionsService) RejectEvent(ctx context.Context, req *v1.RejectEventRequest) (*v1.Event, error) {
+	event, err := s.moderate(ctx, req.GetId(), store.EventStatusRejected, store.AuditActionEventRejected, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return eventToProto(event)
+}
+
+// ---------------
+// Host endpoints.
+// ---------------
+
+// GetMyHostProfile returns the host profile of the authenticated user.
+func (s *CouchConnectionsService) GetMyHostProfile(ctx context.Context, req *empty.Empty) (*v1.Host, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "host profiles require an authenticated user")
+	}
+
+	host, err := s.getMyHost(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return hostToProto(host)
+}
+
+// UpdateMyHostProfile creates or updates the host profile of the authenticated user.
+func (s *CouchConnectionsService) UpdateMyHostProfile(ctx context.Context, req *v1.UpdateMyHostProfileRequest) (*v1.Host, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "host profiles require an authenticated user")
+	}
+
+	host := hostFromProto(req.GetHost())
+	host.UserID = user.Sub
+	host.UpdatedAt = s.now().UTC()
+	if host.Name == "" {
+		host.Name = user.Name
+	}
+	if host.AvatarURL == "" {
+		host.AvatarURL = user.Picture
+	}
+
+	host, err := s.store.SaveHost(host)
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return hostToProto(host)
+}
+
+// GetHost returns a host profile.
+func (s *CouchConnectionsService) GetHost(ctx context.Context, req *v1.GetHostRequest) (*v1.Host, error) {
+	host, err := s.store.GetHostByID(req.GetId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return hostToProto(host)
+}
+
+// ListHostEvents returns the upcoming and past events of a host.
+func (s *CouchConnectionsService) ListHostEvents(ctx context.Context, req *v1.ListHostEventsRequest) (*v1.ListHostEventsResponse, error) {
+	host, err := s.store.GetHostByID(req.GetId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = defaultHostEventsPageSize
+	}
+
+	upcoming, past, err := s.listHostEvents(ctx, host.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListHostEventsResponse{
+		Upcoming: make([]*v1.Event, 0, len(upcoming)),
+		Past:     make([]*v1.Event, 0, len(past)),
+	}
+	if resp.Host, err = hostToProto(host); err != nil {
+		return nil, err
+	}
+	for i := range upcoming {
+		event, err := eventToProto(&upcoming[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Upcoming = append(resp.Upcoming, event)
+	}
+	for i := range past {
+		event, err := eventToProto(&past[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Past = append(resp.Past, event)
+	}
+
+	return resp, nil
+}
+
+// -------------------
+// Category endpoints.
+// -------------------
+
+// ListCategories returns all categories.
+func (s *CouchConnectionsService) ListCategories(ctx context.Context, req *empty.Empty) (*v1.ListCategoriesResponse, error) {
+	categories, err := s.store.GetAllCategories()
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	resp := &v1.ListCategoriesResponse{
+		Categories: make([]*v1.Category, 0, len(categories)),
+	}
+	for i := range categories {
+		resp.Categories = append(resp.Categories, categoryToProto(&categories[i]))
+	}
+
+	return resp, nil
+}
+
+// CreateCategory creates a new category.
+func (s *CouchConnectionsService) CreateCategory(ctx context.Context, req *v1.CreateCategoryRequest) (*v1.Category, error) {
+	if err := s.assertAdmin(ctx, "only admins can manage categories"); err != nil {
+		return nil, err
+	}
+
+	category, err := s.store.CreateCategory(&store.Category{
+		Name:        req.GetCategory().GetName(),
+		Description: req.GetCategory().GetDescription(),
+	})
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return categoryToProto(category), nil
+}
+
+// UpdateCategory updates an existing category.
+func (s *CouchConnectionsService) UpdateCategory(ctx context.Context, req *v1.UpdateCategoryRequest) (*v1.Category, error) {
+	if err := s.assertAdmin(ctx, "only admins can manage categories"); err != nil {
+		return nil, err
+	}
+
+	category, err := s.store.UpdateCategory(&store.Category{
+		ID:          req.GetId(),
+		Name:        req.GetCategory().GetName(),
+		Description: req.GetCategory().GetDescription(),
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return categoryToProto(category), nil
+}
+
+// DeleteCategory deletes a category and removes it from all events.
+func (s *CouchConnectionsService) DeleteCategory(ctx context.Context, req *v1.DeleteCategoryRequest) (*empty.Empty, error) {
+	if err := s.assertAdmin(ctx, "only admins can manage categories"); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteCategory(req.GetId()); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetTagCloud returns the number of upcoming events per tag.
+func (s *CouchConnectionsService) GetTagCloud(ctx context.Context, req *v1.GetTagCloudRequest) (*v1.TagCloud, error) {
+	counts, err := s.countUpcomingTags()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultTagCloudSize
+	}
+	if len(counts) > limit {
+		counts = counts[:limit]
+	}
+
+	resp := &v1.TagCloud{
+		Tags: make([]*v1.TagCount, 0, len(counts)),
+	}
+	for _, count := range counts {
+		resp.Tags = append(resp.Tags, &v1.TagCount{Tag: count.Tag, Count: int32(count.Count)})
+	}
+
+	return resp, nil
+}
+
+// -------------------
+// Calendar endpoints.
+// -------------------
+
+// CreateFeedToken creates a secret token for the personal calendar feed of the authenticated user.
+// Only the hash of the token is stored, so the token is returned only once.
+func (s *CouchConnectionsService) CreateFeedToken(ctx context.Context, req *empty.Empty) (*v1.FeedToken, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "creating a feed token requires an authenticated user")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	err := s.store.SaveFeedToken(&store.FeedToken{
+		UserID:    user.Sub,
+		TokenHash: store.HashToken(token),
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	return &v1.FeedToken{
+		Token: token,
+		Path:  calendar.FeedPath(token),
+	}, nil
+}
+
+// RevokeFeedToken revokes the calendar feed token of the authenticated user.
+func (s *CouchConnectionsService) RevokeFeedToken(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	user := auth.GetUserInfoFromContext(ctx)
+	if user == nil {
+		return nil, twirp.NewError(twirp.Unauthenticated, "revoking a feed token requires an authenticated user")
+	}
+
+	if err := s.store.DeleteFeedToken(user.Sub); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// storeError converts an unexpected error returned by the store to a Twirp error.
+// Not found errors are returned as they are and converted by the gRPC server.
+func storeError(err error) error {
+	if store.IsNotFound(err) {
+		return err
+	}
+	return twirp.InternalErrorWith(err)
+}
+
+// isAdmin returns true if the user of the request is an admin of the service.
+func (s *CouchConnectionsService) isAdmin(ctx context.Context) bool {
+	return s.authorizer != nil && s.authorizer.AssertCapabilityAdmin(ctx) == nil
+}
+
+// assertAdmin returns a PermissionDenied error with the message if the user of the request is not an admin.
+func (s *CouchConnectionsService) assertAdmin(ctx context.Context, message string) error {
+	if !s.isAdmin(ctx) {
+		return twirp.NewError(twirp.PermissionDenied, message)
+	}
+	return nil
+}
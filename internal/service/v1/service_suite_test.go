@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+)
+
+func TestService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Suite")
+}
+
+// newTestAuthorizer returns the authorizer used by the service in tests.
+func newTestAuthorizer() *auth.Authorizer {
+	authorizer, err := auth.NewAuthorizer("couchconnections", nil)
+	Expect(err).ToNot(HaveOccurred())
+	return authorizer
+}
+
+// withAdmin returns a context of an admin user.
+func withAdmin(ctx context.Context) context.Context {
+	return auth.WithAuthorizationPermissions(ctx, []string{"capability:couchconnections:admin"})
+}
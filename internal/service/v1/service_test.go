@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/store"
+	"github.com/sebastianrosch/couchconnections/pkg/auth"
+	v1 "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/v1"
+)
+
+var _ = Describe("CouchConnections service", func() {
+	var service *CouchConnectionsService
+	var ctx context.Context
+	var event *v1.Event
+
+	BeforeEach(func() {
+		service = NewCouchConnectionsService(store.NewMemoryStore(), 15*time.Minute, newTestAuthorizer())
+		ctx = context.Background()
+		start, _ := ptypes.TimestampProto(time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC))
+		event = &v1.Event{
+			Topic:    "How viruses spread",
+			Start:    start,
+			Duration: ptypes.DurationProto(time.Hour),
+			TimeZone: "Europe/Berlin",
+		}
+	})
+
+	Describe("when an event is created", func() {
+		It("should calculate the end time from the duration", func() {
+			created, err := service.CreateEvent(withAdmin(ctx), &v1.CreateEventRequest{Event: event})
+			Expect(err).ToNot(HaveOccurred())
+
+			end, _ := ptypes.Timestamp(created.GetEnd())
+			Expect(end).To(Equal(time.Date(2020, 4, 1, 19, 0, 0, 0, time.UTC)))
+			Expect(created.GetStatus()).To(Equal(v1.EventStatus_EVENT_STATUS_SCHEDULED))
+		})
+
+		It("should reject an end time before the start time", func() {
+			event.End, _ = ptypes.TimestampProto(time.Date(2020, 4, 1, 17, 0, 0, 0, time.UTC))
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.InvalidArgument))
+			Expect(twerr.Meta("argument")).To(Equal("end"))
+		})
+
+		It("should reject an unknown time zone", func() {
+			event.TimeZone = "Europe/Atlantis"
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Meta("argument")).To(Equal("time_zone"))
+		})
+
+		It("should reject the local time zone of the server", func() {
+			event.TimeZone = "Local"
+
+			_, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Meta("argument")).To(Equal("time_zone"))
+		})
+
+		It("should default a missing time zone to UTC", func() {
+			event.TimeZone = ""
+
+			created, err := service.CreateEvent(ctx, &v1.CreateEventRequest{Event: event})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.GetTimeZone()).To(Equal("UTC"))
+		})
+
+		It("should be listed", func() {
+			_, err := service.CreateEvent(withAdmin(ctx), &v1.CreateEventRequest{Event: event})
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := service.ListEvents(ctx, &v1.ListEventsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetEvents()).To(HaveLen(1))
+			Expect(resp.GetEvents()[0].GetTopic()).To(Equal("How viruses spread"))
+		})
+	})
+
+	Describe("when an event does not exist", func() {
+		It("should return a not found error", func() {
+			_, err := service.GetEvent(ctx, &v1.GetEventRequest{Id: "unknown"})
+
+			Expect(store.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("when a user registers for an event", func() {
+		var created *v1.Event
+
+		BeforeEach(func() {
+			event.Capacity = 1
+			created, _ = service.CreateEvent(withAdmin(ctx), &v1.CreateEventRequest{Event: event})
+		})
+
+		It("should require an authenticated user", func() {
+			_, err := service.RegisterForEvent(ctx, &v1.RegisterForEventRequest{EventId: created.GetId()})
+
+			twerr, ok := err.(twirp.Error)
+			Expect(ok).To(BeTrue())
+			Expect(twerr.Code()).To(Equal(twirp.Unauthenticated))
+		})
+
+		It("should waitlist users once the event is fully booked", func() {
+			alice := auth.WithUserInfo(ctx, &auth.UserInfoResponse{Sub: "alice", Name: "Alice"})
+			bob := auth.WithUserInfo(ctx, &auth.UserInfoResponse{Sub: "bob", Name: "Bob"})
+
+			first, err := service.RegisterForEvent(alice, &v1.RegisterForEventRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.GetStatus()).To(Equal(v1.RegistrationStatus_REGISTRATION_STATUS_CONFIRMED))
+
+			second, err := service.RegisterForEvent(bob, &v1.RegisterForEventRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.GetStatus()).To(Equal(v1.RegistrationStatus_REGISTRATION_STATUS_WAITLISTED))
+
+			_, err = service.CancelRegistration(alice, &v1.CancelRegistrationRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := service.ListRegistrations(withAdmin(ctx), &v1.ListRegistrationsRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetRegistrations()).To(HaveLen(1))
+			Expect(resp.GetRegistrations()[0].GetUserId()).To(Equal("bob"))
+			Expect(resp.GetRegistrations()[0].GetStatus()).To(Equal(v1.RegistrationStatus_REGISTRATION_STATUS_CONFIRMED))
+		})
+
+		It("should confirm waitlisted users when the capacity is raised", func() {
+			alice := auth.WithUserInfo(ctx, &auth.UserInfoResponse{Sub: "alice", Name: "Alice"})
+			bob := auth.WithUserInfo(ctx, &auth.UserInfoResponse{Sub: "bob", Name: "Bob"})
+			_, err := service.RegisterForEvent(alice, &v1.RegisterForEventRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = service.RegisterForEvent(bob, &v1.RegisterForEventRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			created.Capacity = 2
+			_, err = service.UpdateEvent(withAdmin(ctx), &v1.UpdateEventRequest{Id: created.GetId(), Event: created})
+			Expect(err).ToNot(HaveOccurred())
+
+			registration, err := service.ListRegistrations(bob, &v1.ListRegistrationsRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(registration.GetRegistrations()[0].GetStatus()).To(Equal(v1.RegistrationStatus_REGISTRATION_STATUS_CONFIRMED))
+		})
+
+		It("should only list the own registration to attendees", func() {
+			alice := auth.WithUserInfo(ctx, &auth.UserInfoResponse{Sub: "alice", Name: "Alice"})
+			bob := auth.WithUserInfo(ctx, &auth.UserInfoResponse{Sub: "bob", Name: "Bob"})
+			_, err := service.RegisterForEvent(alice, &v1.RegisterForEventRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = service.RegisterForEvent(bob, &v1.RegisterForEventRequest{EventId: created.GetId()})
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := service.ListRegistrations(bob, &v1.ListRegistrationsRequest{EventId: created.GetId()})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetRegistrations()).To(HaveLen(1))
+			Expect(resp.GetRegistrations()[0].GetUserId()).To(Equal("bob"))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/sebastianrosch/couchconnections/internal/recurrence"
+	"github.com/sebastianrosch/couchconnections/internal/store"
+)
+
+// validateEvent validates the rules of an event that span multiple fields
+// and therefore can't be expressed in the protobuf definition.
+func validateEvent(event *store.Event) error {
+	if event.End.IsZero() {
+		return twirp.InvalidArgumentError("end", "is required if no duration is set")
+	}
+	if !event.End.After(event.Start) {
+		return twirp.InvalidArgumentError("end", "must be after start")
+	}
+	// LoadLocation also accepts "" as UTC and "Local" as the time zone of the server.
+	// A missing time zone is defaulted to UTC when the event is converted.
+	if event.TimeZone == "" || event.TimeZone == "Local" {
+		return twirp.InvalidArgumentError("time_zone", "must be a valid IANA time zone")
+	}
+	if _, err := time.LoadLocation(event.TimeZone); err != nil {
+		return twirp.InvalidArgumentError("time_zone", "must be a valid IANA time zone")
+	}
+	if event.Recurrence != "" {
+		if _, err := recurrence.Parse(event.Recurrence); err != nil {
+			return twirp.InvalidArgumentError("recurrence", "must be a valid recurrence rule: "+err.Error())
+		}
+	}
+
+	return nil
+}
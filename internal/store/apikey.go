@@ -0,0 +1,54 @@
+package store
+
+import (
+	"time"
+)
+
+// APIKey is a personal API key that authenticates scripts and bots as the user who created it.
+// Only the hash of the key is stored.
+type APIKey struct {
+	ID string `bson:"id"`
+	// UserID is the subject of the identity of the user who created the key.
+	UserID   string `bson:"userId"`
+	UserName string `bson:"userName"`
+	Name     string `bson:"name"`
+	// Prefix is the beginning of the key, which identifies it without revealing it.
+	Prefix      string    `bson:"prefix"`
+	KeyHash     string    `bson:"keyHash"`
+	Permissions []string  `bson:"permissions"`
+	CreatedAt   time.Time `bson:"createdAt"`
+	// ExpiresAt is zero if the key doesn't expire.
+	ExpiresAt  time.Time `bson:"expiresAt"`
+	LastUsedAt time.Time `bson:"lastUsedAt"`
+}
+
+// IsExpired returns true if the key has expired at the given time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// UserPermissions are the permissions of a user the last time they authenticated with an access token.
+// API keys are limited to them, so that keys lose the permissions that are revoked from their owner.
+type UserPermissions struct {
+	UserID      string    `bson:"userId"`
+	Permissions []string  `bson:"permissions"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+// APIKeyStore is implemented by all stores that persist API keys.
+type APIKeyStore interface {
+	// CreateAPIKey stores a new API key.
+	CreateAPIKey(key *APIKey) error
+	// GetAPIKeyByHash returns the API key with the given hash or a NotFoundError.
+	GetAPIKeyByHash(keyHash string) (*APIKey, error)
+	// ListAPIKeys returns the API keys of a user in the order they were created.
+	ListAPIKeys(userID string) ([]APIKey, error)
+	// UpdateAPIKeyLastUsed sets the time an API key was last used or returns a NotFoundError.
+	UpdateAPIKeyLastUsed(id string, lastUsedAt time.Time) error
+	// DeleteAPIKey removes the API key of a user or returns a NotFoundError if the user has no key with the ID.
+	DeleteAPIKey(userID, id string) error
+	// SaveUserPermissions creates or replaces the permissions of a user.
+	SaveUserPermissions(permissions *UserPermissions) error
+	// GetUserPermissions returns the permissions of a user or a NotFoundError.
+	GetUserPermissions(userID string) (*UserPermissions, error)
+}
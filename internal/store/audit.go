@@ -0,0 +1,34 @@
+package store
+
+import "time"
+
+// AuditAction is an action that is recorded in the audit log.
+type AuditAction string
+
+const (
+	// AuditActionJoinLinkAccessed is recorded when a user fetches the join link of an event.
+	AuditActionJoinLinkAccessed AuditAction = "joinLinkAccessed"
+	// AuditActionEventApproved is recorded when an admin approves a submitted event.
+	AuditActionEventApproved AuditAction = "eventApproved"
+	// AuditActionEventRejected is recorded when an admin rejects a submitted event.
+	AuditActionEventRejected AuditAction = "eventRejected"
+)
+
+// AuditEntry records an action a user performed on an event.
+type AuditEntry struct {
+	ID        string      `bson:"id"`
+	EventID   string      `bson:"eventId"`
+	UserID    string      `bson:"userId"`
+	Action    AuditAction `bson:"action"`
+	CreatedAt time.Time   `bson:"createdAt"`
+	// Reason is the reason the user gave for the action, e.g. for rejecting an event.
+	Reason string `bson:"reason,omitempty"`
+}
+
+// AuditStore is implemented by all stores that persist the audit log.
+type AuditStore interface {
+	// RecordAuditEntry adds an entry to the audit log and assigns it a new ID.
+	RecordAuditEntry(entry *AuditEntry) error
+	// ListAuditEntries returns all audit entries for an event in the order they were recorded.
+	ListAuditEntries(eventID string) ([]AuditEntry, error)
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"sort"
+)
+
+// Category is a category curated by admins to classify events, e.g. "Health" or "Tech".
+type Category struct {
+	ID          string `bson:"id"`
+	Name        string `bson:"name"`
+	Description string `bson:"description"`
+}
+
+// TagCount is the number of events with a tag.
+type TagCount struct {
+	Tag   string `bson:"_id"`
+	Count int    `bson:"count"`
+}
+
+// CategoryStore is implemented by all stores that persist event categories.
+type CategoryStore interface {
+	// GetAllCategories returns all categories ordered by name.
+	GetAllCategories() ([]Category, error)
+	// GetCategoryByID returns the category with the given ID or a NotFoundError.
+	GetCategoryByID(id string) (*Category, error)
+	// CreateCategory adds a new category and assigns it a new ID.
+	CreateCategory(category *Category) (*Category, error)
+	// UpdateCategory replaces the category with the same ID or returns a NotFoundError.
+	UpdateCategory(category *Category) (*Category, error)
+	// DeleteCategory removes the category with the given ID from the store and from all events
+	// and series or returns a NotFoundError.
+	DeleteCategory(id string) error
+}
+
+// CountTags returns the number of events per tag, ordered by count and tag.
+func CountTags(events []Event) []TagCount {
+	counts := map[string]int{}
+	for i := range events {
+		for _, tag := range events[i].Tags {
+			counts[tag]++
+		}
+	}
+
+	return tagCounts(counts)
+}
+
+// MergeTagCounts returns the sum of the counts per tag, ordered by count and tag.
+func MergeTagCounts(lists ...[]TagCount) []TagCount {
+	counts := map[string]int{}
+	for _, list := range lists {
+		for _, count := range list {
+			counts[count.Tag] += count.Count
+		}
+	}
+
+	return tagCounts(counts)
+}
+
+// tagCounts returns the counts per tag, ordered by count and tag.
+func tagCounts(counts map[string]int) []TagCount {
+	results := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		results = append(results, TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Count == results[j].Count {
+			return results[i].Tag < results[j].Tag
+		}
+		return results[i].Count > results[j].Count
+	})
+
+	return results
+}
@@ -0,0 +1,31 @@
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// FeedToken is the secret token that gives access to the calendar feed of a user.
+// Only the hash of the token is stored.
+type FeedToken struct {
+	UserID    string    `bson:"userId"`
+	TokenHash string    `bson:"tokenHash"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+// HashToken returns the hash of a secret token that is used to store and look up the token.
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// FeedTokenStore is implemented by all stores that persist calendar feed tokens.
+type FeedTokenStore interface {
+	// SaveFeedToken stores the feed token of a user and replaces any previous token of the user.
+	SaveFeedToken(token *FeedToken) error
+	// GetFeedTokenByHash returns the feed token with the given hash or a NotFoundError.
+	GetFeedTokenByHash(tokenHash string) (*FeedToken, error)
+	// DeleteFeedToken removes the feed token of a user or returns a NotFoundError.
+	DeleteFeedToken(userID string) error
+}
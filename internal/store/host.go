@@ -0,0 +1,34 @@
+package store
+
+import (
+	"time"
+)
+
+// Host is the public profile of a user who presents events.
+type Host struct {
+	ID string `bson:"id"`
+	// UserID is the subject of the identity of the user.
+	UserID    string     `bson:"userId"`
+	Name      string     `bson:"name"`
+	Bio       string     `bson:"bio"`
+	AvatarURL string     `bson:"avatarUrl"`
+	Links     []HostLink `bson:"links,omitempty"`
+	UpdatedAt time.Time  `bson:"updatedAt"`
+}
+
+// HostLink is a link on the profile of a host, e.g. to a website or social media account.
+type HostLink struct {
+	Title string `bson:"title"`
+	URL   string `bson:"url"`
+}
+
+// HostStore is implemented by all stores that persist host profiles.
+type HostStore interface {
+	// GetHostByID returns the host with the given ID or a NotFoundError.
+	GetHostByID(id string) (*Host, error)
+	// GetHostByUserID returns the host profile of a user or a NotFoundError.
+	GetHostByUserID(userID string) (*Host, error)
+	// SaveHost creates or replaces the host profile of a user. A new profile is assigned a new ID,
+	// an existing profile keeps its ID.
+	SaveHost(host *Host) (*Host, error)
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+var (
+	entropyMutex sync.Mutex
+	entropy      = ulid.Monotonic(rand.Reader, 0)
+)
+
+// NewID returns a new unique identifier.
+// The identifiers are ULIDs, which sort lexicographically in the order they were created.
+func NewID() string {
+	entropyMutex.Lock()
+	defer entropyMutex.Unlock()
+
+	return ulid.MustNew(ulid.Timestamp(time.Now()), entropy).String()
+}
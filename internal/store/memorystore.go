@@ -0,0 +1,216 @@
+package store
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sebastianrosch/couchconnections/internal/search"
+)
+
+var _ Store = &MemoryStore{}
+
+// MemoryStore is a thread-safe service store that keeps all data in memory.
+// It is meant for local development and tests and loses all data on restart.
+type MemoryStore struct {
+	mutex         sync.RWMutex
+	events        map[string]Event
+	registrations map[string][]Registration
+	auditEntries  map[string][]AuditEntry
+	feedTokens    map[string]FeedToken
+	series        map[string]Series
+	categories    map[string]Category
+	hosts         map[string]Host
+	apiKeys       map[string]APIKey
+	permissions   map[string]UserPermissions
+	searchIndex   *search.Index
+}
+
+// NewMemoryStore returns an empty instance of MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		events:        map[string]Event{},
+		registrations: map[string][]Registration{},
+		auditEntries:  map[string][]AuditEntry{},
+		feedTokens:    map[string]FeedToken{},
+		series:        map[string]Series{},
+		categories:    map[string]Category{},
+		hosts:         map[string]Host{},
+		apiKeys:       map[string]APIKey{},
+		permissions:   map[string]UserPermissions{},
+		searchIndex:   search.NewIndex(EventSearchWeights),
+	}
+}
+
+// Name gets the name of this db implementation.
+func (s *MemoryStore) Name() string {
+	return "memory"
+}
+
+// CheckReadiness checks the readiness of the db and returns an error if it's
+// not ready. The memory store is always ready.
+func (s *MemoryStore) CheckReadiness() error {
+	return nil
+}
+
+// GetAllEvents returns all events ordered by their start time.
+func (s *MemoryStore) GetAllEvents() ([]Event, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := make([]Event, 0, len(s.events))
+	for _, event := range s.events {
+		results = append(results, copyEvent(event))
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Start.Equal(results[j].Start) {
+			return results[i].ID < results[j].ID
+		}
+		return results[i].Start.Before(results[j].Start)
+	})
+
+	return results, nil
+}
+
+// GetEventsBetween returns all events that overlap with the time between from and to, ordered by their start time.
+func (s *MemoryStore) GetEventsBetween(from, to time.Time) ([]Event, error) {
+	events, err := s.GetAllEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	results := events[:0]
+	for _, event := range events {
+		if event.Overlaps(from, to) {
+			results = append(results, event)
+		}
+	}
+
+	return results, nil
+}
+
+// ListEvents returns the events selected by the query in the requested order.
+func (s *MemoryStore) ListEvents(query *EventQuery) ([]Event, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := []Event{}
+	for _, event := range s.events {
+		if query.Matches(&event) {
+			results = append(results, copyEvent(event))
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return query.Less(results[i].Start, results[i].ID, &results[j])
+	})
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+
+	return results, nil
+}
+
+// CountTags returns the number of events selected by the query per tag.
+func (s *MemoryStore) CountTags(query *EventQuery) ([]TagCount, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var events []Event
+	for _, event := range s.events {
+		if query.Matches(&event) {
+			events = append(events, event)
+		}
+	}
+
+	return CountTags(events), nil
+}
+
+// SearchEvents returns the events that match the query, ordered by relevance.
+func (s *MemoryStore) SearchEvents(query *SearchQuery) ([]SearchResult, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := []SearchResult{}
+	for _, match := range s.searchIndex.Search(query.Text) {
+		if query.Limit > 0 && len(results) == query.Limit {
+			break
+		}
+		event := s.events[match.ID]
+		if !query.Matches(&event) {
+			continue
+		}
+		results = append(results, SearchResult{Event: copyEvent(event), Score: match.Score})
+	}
+
+	return results, nil
+}
+
+// GetEventByID returns the event or the occurrence of a series with the given ID.
+func (s *MemoryStore) GetEventByID(id string) (*Event, error) {
+	if seriesID, originalStart, ok := ParseOccurrenceID(id); ok {
+		return getOccurrence(s, seriesID, originalStart)
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	event, ok := s.events[id]
+	if !ok {
+		return nil, NewNotFoundError("event", id)
+	}
+	event = copyEvent(event)
+
+	return &event, nil
+}
+
+// CreateEvent adds a new event and assigns it a new ID.
+func (s *MemoryStore) CreateEvent(event *Event) (*Event, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	event.ID = NewID()
+	s.events[event.ID] = copyEvent(*event)
+	s.searchIndex.Add(event.ID, searchFields(event))
+
+	return event, nil
+}
+
+// UpdateEvent replaces the event with the same ID.
+func (s *MemoryStore) UpdateEvent(event *Event) (*Event, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.events[event.ID]; !ok {
+		return nil, NewNotFoundError("event", event.ID)
+	}
+	s.events[event.ID] = copyEvent(*event)
+	s.searchIndex.Add(event.ID, searchFields(event))
+
+	return event, nil
+}
+
+// DeleteEvent removes the event with the given ID.
+func (s *MemoryStore) DeleteEvent(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.events[id]; !ok {
+		return NewNotFoundError("event", id)
+	}
+	delete(s.events, id)
+	delete(s.registrations, id)
+	s.searchIndex.Remove(id)
+
+	return nil
+}
+
+// copyEvent returns a copy of the event that doesn't share its tags and co-hosts.
+func copyEvent(event Event) Event {
+	if event.Tags != nil {
+		event.Tags = append([]string{}, event.Tags...)
+	}
+	if event.CoHostIDs != nil {
+		event.CoHostIDs = append([]string{}, event.CoHostIDs...)
+	}
+	return event
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"sort"
+	"time"
+)
+
+// CreateAPIKey stores a new API key.
+func (s *MemoryStore) CreateAPIKey(key *APIKey) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored := *key
+	stored.Permissions = append([]string{}, key.Permissions...)
+	s.apiKeys[key.ID] = stored
+
+	return nil
+}
+
+// GetAPIKeyByHash returns the API key with the given hash.
+func (s *MemoryStore) GetAPIKeyByHash(keyHash string) (*APIKey, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, key := range s.apiKeys {
+		if key.KeyHash == keyHash {
+			return &key, nil
+		}
+	}
+
+	return nil, NewNotFoundError("API key", keyHash)
+}
+
+// ListAPIKeys returns the API keys of a user in the order they were created.
+func (s *MemoryStore) ListAPIKeys(userID string) ([]APIKey, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	keys := []APIKey{}
+	for _, key := range s.apiKeys {
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+
+	return keys, nil
+}
+
+// UpdateAPIKeyLastUsed sets the time an API key was last used.
+func (s *MemoryStore) UpdateAPIKeyLastUsed(id string, lastUsedAt time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key, ok := s.apiKeys[id]
+	if !ok {
+		return NewNotFoundError("API key", id)
+	}
+	key.LastUsedAt = lastUsedAt
+	s.apiKeys[id] = key
+
+	return nil
+}
+
+// DeleteAPIKey removes the API key of a user.
+func (s *MemoryStore) DeleteAPIKey(userID, id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if key, ok := s.apiKeys[id]; !ok || key.UserID != userID {
+		return NewNotFoundError("API key", id)
+	}
+	delete(s.apiKeys, id)
+
+	return nil
+}
+
+// SaveUserPermissions creates or replaces the permissions of a user.
+func (s *MemoryStore) SaveUserPermissions(permissions *UserPermissions) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored := *permissions
+	stored.Permissions = append([]string{}, permissions.Permissions...)
+	s.permissions[permissions.UserID] = stored
+
+	return nil
+}
+
+// GetUserPermissions returns the permissions of a user.
+func (s *MemoryStore) GetUserPermissions(userID string) (*UserPermissions, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	permissions, ok := s.permissions[userID]
+	if !ok {
+		return nil, NewNotFoundError("user permissions", userID)
+	}
+
+	return &permissions, nil
+}
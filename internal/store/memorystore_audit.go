@@ -0,0 +1,28 @@
+package store
+
+import "time"
+
+// RecordAuditEntry adds an entry to the audit log and assigns it a new ID.
+func (s *MemoryStore) RecordAuditEntry(entry *AuditEntry) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry.ID = NewID()
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	s.auditEntries[entry.EventID] = append(s.auditEntries[entry.EventID], *entry)
+
+	return nil
+}
+
+// ListAuditEntries returns all audit entries for an event in the order they were recorded.
+func (s *MemoryStore) ListAuditEntries(eventID string) ([]AuditEntry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := make([]AuditEntry, len(s.auditEntries[eventID]))
+	copy(results, s.auditEntries[eventID])
+
+	return results, nil
+}
@@ -0,0 +1,83 @@
+package store
+
+import (
+	"sort"
+)
+
+// GetAllCategories returns all categories ordered by name.
+func (s *MemoryStore) GetAllCategories() ([]Category, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := make([]Category, 0, len(s.categories))
+	for _, category := range s.categories {
+		results = append(results, category)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	return results, nil
+}
+
+// GetCategoryByID returns the category with the given ID.
+func (s *MemoryStore) GetCategoryByID(id string) (*Category, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	category, ok := s.categories[id]
+	if !ok {
+		return nil, NewNotFoundError("category", id)
+	}
+
+	return &category, nil
+}
+
+// CreateCategory adds a new category and assigns it a new ID.
+func (s *MemoryStore) CreateCategory(category *Category) (*Category, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	category.ID = NewID()
+	s.categories[category.ID] = *category
+
+	return category, nil
+}
+
+// UpdateCategory replaces the category with the same ID.
+func (s *MemoryStore) UpdateCategory(category *Category) (*Category, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.categories[category.ID]; !ok {
+		return nil, NewNotFoundError("category", category.ID)
+	}
+	s.categories[category.ID] = *category
+
+	return category, nil
+}
+
+// DeleteCategory removes the category with the given ID from the store and from all events and series.
+func (s *MemoryStore) DeleteCategory(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.categories[id]; !ok {
+		return NewNotFoundError("category", id)
+	}
+	delete(s.categories, id)
+	for eventID, event := range s.events {
+		if event.CategoryID == id {
+			event.CategoryID = ""
+			s.events[eventID] = event
+		}
+	}
+	for seriesID, series := range s.series {
+		if series.Event.CategoryID == id {
+			series.Event.CategoryID = ""
+			s.series[seriesID] = series
+		}
+	}
+
+	return nil
+}
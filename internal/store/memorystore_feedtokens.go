@@ -0,0 +1,38 @@
+package store
+
+// SaveFeedToken stores the feed token of a user and replaces any previous token of the user.
+func (s *MemoryStore) SaveFeedToken(token *FeedToken) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.feedTokens[token.UserID] = *token
+
+	return nil
+}
+
+// GetFeedTokenByHash returns the feed token with the given hash.
+func (s *MemoryStore) GetFeedTokenByHash(tokenHash string) (*FeedToken, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, token := range s.feedTokens {
+		if token.TokenHash == tokenHash {
+			return &token, nil
+		}
+	}
+
+	return nil, NewNotFoundError("feed token", tokenHash)
+}
+
+// DeleteFeedToken removes the feed token of a user.
+func (s *MemoryStore) DeleteFeedToken(userID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.feedTokens[userID]; !ok {
+		return NewNotFoundError("feed token", userID)
+	}
+	delete(s.feedTokens, userID)
+
+	return nil
+}
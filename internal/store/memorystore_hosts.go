@@ -0,0 +1,54 @@
+package store
+
+// GetHostByID returns the host with the given ID.
+func (s *MemoryStore) GetHostByID(id string) (*Host, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	host, ok := s.hosts[id]
+	if !ok {
+		return nil, NewNotFoundError("host", id)
+	}
+	host = copyHost(host)
+
+	return &host, nil
+}
+
+// GetHostByUserID returns the host profile of a user.
+func (s *MemoryStore) GetHostByUserID(userID string) (*Host, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, host := range s.hosts {
+		if host.UserID == userID {
+			host = copyHost(host)
+			return &host, nil
+		}
+	}
+
+	return nil, NewNotFoundError("host", userID)
+}
+
+// SaveHost creates or replaces the host profile of a user.
+func (s *MemoryStore) SaveHost(host *Host) (*Host, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	host.ID = NewID()
+	for _, existing := range s.hosts {
+		if existing.UserID == host.UserID {
+			host.ID = existing.ID
+		}
+	}
+	s.hosts[host.ID] = copyHost(*host)
+
+	return host, nil
+}
+
+// copyHost returns a copy of the host that doesn't share its links.
+func copyHost(host Host) Host {
+	if host.Links != nil {
+		host.Links = append([]HostLink{}, host.Links...)
+	}
+	return host
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"sort"
+	"time"
+)
+
+// RegisterForEvent registers a user for an event with the given capacity.
+func (s *MemoryStore) RegisterForEvent(registration *Registration, capacity int) (*Registration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	registrations := s.registrations[registration.EventID]
+	confirmed := 0
+	for _, existing := range registrations {
+		if existing.UserID == registration.UserID {
+			return &existing, nil
+		}
+		if existing.Status == RegistrationStatusConfirmed {
+			confirmed++
+		}
+	}
+
+	registration.ID = NewID()
+	registration.CreatedAt = time.Now()
+	registration.Status = RegistrationStatusConfirmed
+	if capacity > 0 && confirmed >= capacity {
+		registration.Status = RegistrationStatusWaitlisted
+	}
+	s.registrations[registration.EventID] = append(registrations, *registration)
+
+	return registration, nil
+}
+
+// CancelRegistration removes the registration of a user for an event with the given capacity.
+func (s *MemoryStore) CancelRegistration(eventID, userID string, capacity int) (*Registration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	registrations := s.registrations[eventID]
+	for i, existing := range registrations {
+		if existing.UserID != userID {
+			continue
+		}
+
+		s.registrations[eventID] = append(registrations[:i], registrations[i+1:]...)
+		if existing.Status != RegistrationStatusConfirmed {
+			return nil, nil
+		}
+
+		promoted := s.fillSeats(eventID, capacity)
+		if len(promoted) == 0 {
+			return nil, nil
+		}
+		return &promoted[0], nil
+	}
+
+	return nil, NewNotFoundError("registration", userID)
+}
+
+// FillSeats confirms waitlisted registrations of an event until the capacity is reached.
+func (s *MemoryStore) FillSeats(eventID string, capacity int) ([]Registration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.fillSeats(eventID, capacity), nil
+}
+
+// fillSeats confirms waitlisted registrations of an event until the capacity is reached. The mutex must be held.
+func (s *MemoryStore) fillSeats(eventID string, capacity int) []Registration {
+	registrations := s.registrations[eventID]
+	confirmed := 0
+	for _, registration := range registrations {
+		if registration.Status == RegistrationStatusConfirmed {
+			confirmed++
+		}
+	}
+
+	// Registrations are kept in the order they were made, so the first
+	// waitlisted registration is the one that waited the longest.
+	var promoted []Registration
+	for i := range registrations {
+		if capacity > 0 && confirmed >= capacity {
+			break
+		}
+		if registrations[i].Status == RegistrationStatusWaitlisted {
+			registrations[i].Status = RegistrationStatusConfirmed
+			promoted = append(promoted, registrations[i])
+			confirmed++
+		}
+	}
+
+	return promoted
+}
+
+// GetRegistration returns the registration of a user for an event.
+func (s *MemoryStore) GetRegistration(eventID, userID string) (*Registration, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, existing := range s.registrations[eventID] {
+		if existing.UserID == userID {
+			return &existing, nil
+		}
+	}
+
+	return nil, NewNotFoundError("registration", userID)
+}
+
+// ListRegistrations returns all registrations for an event in the order they were made.
+func (s *MemoryStore) ListRegistrations(eventID string) ([]Registration, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := make([]Registration, len(s.registrations[eventID]))
+	copy(results, s.registrations[eventID])
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
+
+// ListRegistrationsByUser returns all registrations of a user in the order they were made.
+func (s *MemoryStore) ListRegistrationsByUser(userID string) ([]Registration, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var results []Registration
+	for _, registrations := range s.registrations {
+		for _, registration := range registrations {
+			if registration.UserID == userID {
+				results = append(results, registration)
+			}
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
+	})
+
+	return results, nil
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Memory store registrations", func() {
+	var store *MemoryStore
+
+	BeforeEach(func() {
+		store = NewMemoryStore()
+	})
+
+	countByStatus := func(eventID string) map[RegistrationStatus]int {
+		registrations, err := store.ListRegistrations(eventID)
+		Expect(err).ToNot(HaveOccurred())
+
+		counts := map[RegistrationStatus]int{}
+		for _, registration := range registrations {
+			counts[registration.Status]++
+		}
+		return counts
+	}
+
+	Describe("when users register concurrently", func() {
+		It("should never confirm more registrations than the capacity", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					_, err := store.RegisterForEvent(&Registration{EventID: "event", UserID: fmt.Sprintf("user-%d", i)}, 10)
+					Expect(err).ToNot(HaveOccurred())
+				}(i)
+			}
+			wg.Wait()
+
+			counts := countByStatus("event")
+			Expect(counts[RegistrationStatusConfirmed]).To(Equal(10))
+			Expect(counts[RegistrationStatusWaitlisted]).To(Equal(40))
+		})
+	})
+
+	Describe("when a user registers twice", func() {
+		It("should return the existing registration", func() {
+			first, err := store.RegisterForEvent(&Registration{EventID: "event", UserID: "alice"}, 1)
+			Expect(err).ToNot(HaveOccurred())
+			second, err := store.RegisterForEvent(&Registration{EventID: "event", UserID: "alice"}, 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(second.ID).To(Equal(first.ID))
+			Expect(countByStatus("event")[RegistrationStatusConfirmed]).To(Equal(1))
+		})
+	})
+
+	Describe("when a confirmed registration is cancelled", func() {
+		It("should confirm the first waitlisted registration", func() {
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "alice"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "bob"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "carol"}, 1)
+
+			promoted, err := store.CancelRegistration("event", "alice", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted.UserID).To(Equal("bob"))
+
+			registration, err := store.GetRegistration("event", "bob")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(registration.Status).To(Equal(RegistrationStatusConfirmed))
+			Expect(countByStatus("event")[RegistrationStatusWaitlisted]).To(Equal(1))
+		})
+
+		It("should confirm waitlisted registrations up to a raised capacity", func() {
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "alice"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "bob"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "carol"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "dave"}, 1)
+
+			promoted, err := store.CancelRegistration("event", "alice", 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted.UserID).To(Equal("bob"))
+			Expect(countByStatus("event")[RegistrationStatusConfirmed]).To(Equal(2))
+		})
+
+		It("should return a not found error for unknown registrations", func() {
+			_, err := store.CancelRegistration("event", "alice", 1)
+			Expect(IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("when the capacity of an event is raised", func() {
+		It("should confirm the waitlisted registrations in the order they were made", func() {
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "alice"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "bob"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "carol"}, 1)
+			store.RegisterForEvent(&Registration{EventID: "event", UserID: "dave"}, 1)
+
+			promoted, err := store.FillSeats("event", 3)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted).To(HaveLen(2))
+			Expect(promoted[0].UserID).To(Equal("bob"))
+			Expect(promoted[1].UserID).To(Equal("carol"))
+			Expect(countByStatus("event")[RegistrationStatusWaitlisted]).To(Equal(1))
+		})
+	})
+})
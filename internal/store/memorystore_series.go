@@ -0,0 +1,96 @@
+package store
+
+import (
+	"sort"
+	"strings"
+)
+
+// GetAllSeries returns all series ordered by the start of their first occurrence.
+func (s *MemoryStore) GetAllSeries() ([]Series, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := make([]Series, 0, len(s.series))
+	for _, series := range s.series {
+		results = append(results, copySeries(series))
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Event.Start.Equal(results[j].Event.Start) {
+			return results[i].ID < results[j].ID
+		}
+		return results[i].Event.Start.Before(results[j].Event.Start)
+	})
+
+	return results, nil
+}
+
+// GetSeriesByID returns the series with the given ID.
+func (s *MemoryStore) GetSeriesByID(id string) (*Series, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	series, ok := s.series[id]
+	if !ok {
+		return nil, NewNotFoundError("series", id)
+	}
+	series = copySeries(series)
+
+	return &series, nil
+}
+
+// CreateSeries adds a new series and assigns it a new ID.
+func (s *MemoryStore) CreateSeries(series *Series) (*Series, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	series.ID = NewID()
+	s.series[series.ID] = copySeries(*series)
+
+	return series, nil
+}
+
+// UpdateSeries replaces the series with the same ID.
+func (s *MemoryStore) UpdateSeries(series *Series) (*Series, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.series[series.ID]; !ok {
+		return nil, NewNotFoundError("series", series.ID)
+	}
+	s.series[series.ID] = copySeries(*series)
+
+	return series, nil
+}
+
+// DeleteSeries removes the series with the given ID and the registrations for its occurrences.
+func (s *MemoryStore) DeleteSeries(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.series[id]; !ok {
+		return NewNotFoundError("series", id)
+	}
+	delete(s.series, id)
+	for eventID := range s.registrations {
+		if strings.HasPrefix(eventID, id+"_") {
+			delete(s.registrations, eventID)
+		}
+	}
+
+	return nil
+}
+
+// copySeries returns a copy of the series that doesn't share its event and exceptions.
+func copySeries(series Series) Series {
+	series.Event = copyEvent(series.Event)
+	exceptions := make([]SeriesException, len(series.Exceptions))
+	for i, exception := range series.Exceptions {
+		if exception.Event != nil {
+			event := copyEvent(*exception.Event)
+			exception.Event = &event
+		}
+		exceptions[i] = exception
+	}
+	series.Exceptions = exceptions
+	return series
+}
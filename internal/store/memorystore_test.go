@@ -0,0 +1,150 @@
+package store
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Memory store", func() {
+	var store *MemoryStore
+	var start time.Time
+
+	BeforeEach(func() {
+		store = NewMemoryStore()
+		start = time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+	})
+
+	Describe("when an event is created", func() {
+		It("should be assigned a new ID", func() {
+			first, err := store.CreateEvent(&Event{Topic: "How viruses spread", Start: start})
+			Expect(err).ToNot(HaveOccurred())
+			second, err := store.CreateEvent(&Event{ID: first.ID, Topic: "Remote work", Start: start})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(first.ID).ToNot(BeEmpty())
+			Expect(second.ID).ToNot(Equal(first.ID))
+			Expect(second.ID > first.ID).To(BeTrue())
+		})
+
+		It("should be returned by its ID", func() {
+			created, err := store.CreateEvent(&Event{Topic: "How viruses spread", Start: start})
+			Expect(err).ToNot(HaveOccurred())
+
+			event, err := store.GetEventByID(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Topic).To(Equal("How viruses spread"))
+		})
+
+		It("should not be modified through the returned pointer", func() {
+			created, _ := store.CreateEvent(&Event{Topic: "How viruses spread", Start: start})
+			created.Topic = "Changed"
+
+			event, _ := store.GetEventByID(created.ID)
+			Expect(event.Topic).To(Equal("How viruses spread"))
+		})
+
+		It("should not share its tags and co-hosts with readers", func() {
+			created, _ := store.CreateEvent(&Event{Topic: "How viruses spread", Start: start, Tags: []string{"science"}, CoHostIDs: []string{"ben"}})
+
+			event, _ := store.GetEventByID(created.ID)
+			event.Tags[0] = "changed"
+			events, _ := store.ListEvents(&EventQuery{})
+			events[0].CoHostIDs[0] = "changed"
+			all, _ := store.GetAllEvents()
+			all[0].Tags[0] = "changed"
+
+			event, _ = store.GetEventByID(created.ID)
+			Expect(event.Tags).To(Equal([]string{"science"}))
+			Expect(event.CoHostIDs).To(Equal([]string{"ben"}))
+		})
+	})
+
+	Describe("when all events are listed", func() {
+		It("should return them ordered by start time", func() {
+			store.CreateEvent(&Event{Topic: "Later", Start: start.Add(time.Hour)})
+			store.CreateEvent(&Event{Topic: "Earlier", Start: start})
+
+			events, err := store.GetAllEvents()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].Topic).To(Equal("Earlier"))
+			Expect(events[1].Topic).To(Equal("Later"))
+		})
+	})
+
+	Describe("when events are queried", func() {
+		BeforeEach(func() {
+			store.CreateEvent(&Event{Topic: "First", Host: "Anna", Start: start, End: start.Add(time.Hour), Language: "de"})
+			store.CreateEvent(&Event{Topic: "Second", Host: "Ben", Start: start, End: start.Add(time.Hour), Language: "en"})
+			store.CreateEvent(&Event{Topic: "Third", Host: "Anna", Start: start.Add(time.Hour), End: start.Add(2 * time.Hour), Language: "en"})
+		})
+
+		topics := func(events []Event) []string {
+			results := make([]string, len(events))
+			for i := range events {
+				results[i] = events[i].Topic
+			}
+			return results
+		}
+
+		It("should return the events that match the filters", func() {
+			events, err := store.ListEvents(&EventQuery{Host: "Anna", Language: "en"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(topics(events)).To(Equal([]string{"Third"}))
+		})
+
+		It("should return the unpublished events of their owner only", func() {
+			store.CreateEvent(&Event{Topic: "Draft", OwnerID: "anna", Start: start, End: start.Add(time.Hour), Status: EventStatusDraft})
+
+			events, err := store.ListEvents(&EventQuery{Published: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(topics(events)).To(Equal([]string{"First", "Second", "Third"}))
+
+			events, err = store.ListEvents(&EventQuery{Published: true, OwnerID: "anna"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(topics(events)).To(ConsistOf("First", "Second", "Draft", "Third"))
+		})
+
+		It("should return the pages that follow the cursor", func() {
+			first, err := store.ListEvents(&EventQuery{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(topics(first)).To(Equal([]string{"First", "Second"}))
+
+			next, err := store.ListEvents(&EventQuery{Limit: 2, After: first[1].Cursor()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(topics(next)).To(Equal([]string{"Third"}))
+		})
+
+		It("should return the events in descending order", func() {
+			events, err := store.ListEvents(&EventQuery{Order: SortDescending, After: &EventCursor{Start: start.Add(time.Hour), ID: "~"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(topics(events)).To(Equal([]string{"Third", "Second", "First"}))
+		})
+	})
+
+	Describe("when an event does not exist", func() {
+		It("should return a NotFoundError on get, update and delete", func() {
+			_, err := store.GetEventByID("unknown")
+			Expect(IsNotFound(err)).To(BeTrue())
+
+			_, err = store.UpdateEvent(&Event{ID: "unknown"})
+			Expect(IsNotFound(err)).To(BeTrue())
+
+			err = store.DeleteEvent("unknown")
+			Expect(IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("when an event is deleted", func() {
+		It("should not be returned anymore", func() {
+			created, _ := store.CreateEvent(&Event{Topic: "How viruses spread", Start: start})
+
+			Expect(store.DeleteEvent(created.ID)).To(Succeed())
+
+			_, err := store.GetEventByID(created.ID)
+			Expect(IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"sort"
 	"time"
 
 	"github.com/sebastianrosch/couchconnections/internal/db"
@@ -9,29 +10,90 @@ import (
 	// "github.com/sebastianrosch/couchconnections/pkg/types"
 
 	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
 	"github.com/pkg/errors"
 )
 
 const (
 	// EventsCollection the collection name of the events collection
 	EventsCollection = "lrp.events"
-	// EventsIndex the index name for the events.from index
+	// EventsIndex the index name for the unique events.id index
 	EventsIndex = "index.events.id"
+	// RegistrationsCollection the collection name of the registrations collection
+	RegistrationsCollection = "lrp.registrations"
+	// RegistrationsIndex the index name for the unique registrations.eventId.userId index
+	RegistrationsIndex = "index.registrations.eventId.userId"
+	// SeatsCollection the collection name of the seats collection, which counts the confirmed registrations per event
+	SeatsCollection = "lrp.seats"
+	// SeatsIndex the index name for the unique seats.eventId index
+	SeatsIndex = "index.seats.eventId"
+	// AuditCollection the collection name of the audit log collection
+	AuditCollection = "lrp.audit"
+	// AuditIndex the index name for the audit.eventId.id index
+	AuditIndex = "index.audit.eventId.id"
+	// RegistrationsUserIndex the index name for the registrations.userId index
+	RegistrationsUserIndex = "index.registrations.userId"
+	// FeedTokensCollection the collection name of the calendar feed tokens collection
+	FeedTokensCollection = "lrp.feedTokens"
+	// FeedTokensUserIndex the index name for the unique feedTokens.userId index
+	FeedTokensUserIndex = "index.feedTokens.userId"
+	// FeedTokensHashIndex the index name for the unique feedTokens.tokenHash index
+	FeedTokensHashIndex = "index.feedTokens.tokenHash"
+	// SeriesCollection the collection name of the event series collection
+	SeriesCollection = "lrp.series"
+	// SeriesIndex the index name for the unique series.id index
+	SeriesIndex = "index.series.id"
+	// EventsStartIndex the index name for the events.start.id index, which is used to list events
+	EventsStartIndex = "index.events.start.id"
+	// EventsHostIndex the index name for the events.host.start index, which is used to list the events of a host
+	EventsHostIndex = "index.events.host.start"
+	// EventsTagsIndex the index name for the multikey events.tags.start index
+	EventsTagsIndex = "index.events.tags.start"
+	// CategoriesCollection the collection name of the event categories collection
+	CategoriesCollection = "lrp.categories"
+	// CategoriesIndex the index name for the unique categories.id index
+	CategoriesIndex = "index.categories.id"
+	// EventsHostIDIndex the index name for the events.hostId.start index
+	EventsHostIDIndex = "index.events.hostId.start"
+	// EventsCoHostIDsIndex the index name for the multikey events.coHostIds.start index
+	EventsCoHostIDsIndex = "index.events.coHostIds.start"
+	// HostsCollection the collection name of the host profiles collection
+	HostsCollection = "lrp.hosts"
+	// HostsIndex the index name for the unique hosts.id index
+	HostsIndex = "index.hosts.id"
+	// HostsUserIndex the index name for the unique hosts.userId index
+	HostsUserIndex = "index.hosts.userId"
+	// APIKeysCollection the collection name of the API keys collection
+	APIKeysCollection = "lrp.apiKeys"
+	// APIKeysIndex the index name for the unique apiKeys.id index
+	APIKeysIndex = "index.apiKeys.id"
+	// APIKeysHashIndex the index name for the unique apiKeys.keyHash index
+	APIKeysHashIndex = "index.apiKeys.keyHash"
+	// APIKeysUserIndex the index name for the apiKeys.userId.id index
+	APIKeysUserIndex = "index.apiKeys.userId.id"
+	// UserPermissionsCollection the collection name of the user permissions collection, which limits the permissions of API keys
+	UserPermissionsCollection = "lrp.userPermissions"
+	// UserPermissionsIndex the index name for the unique userPermissions.userId index
+	UserPermissionsIndex = "index.userPermissions.userId"
+	// EventsTextIndex the index name for the text index of the searchable event fields
+	EventsTextIndex = "index.events.text"
 )
 
-type Event struct {
-	ID          string    `bson:"id"`
-	Topic       string    `bson:"topic"`
-	Description string    `bson:"description"`
-	Host        string    `bson:"host"`
-	ZoomLink    string    `bson:"zoomLink"`
-	Start       time.Time `bson:"start"`
-}
+var _ Store = &MongoStore{}
 
 // MongoStore is the service store for MongoDB.
 type MongoStore struct {
-	db     *mgo.Database
-	events *mgo.Collection
+	db            *mgo.Database
+	events        *mgo.Collection
+	registrations *mgo.Collection
+	seats         *mgo.Collection
+	audit         *mgo.Collection
+	feedTokens    *mgo.Collection
+	series        *mgo.Collection
+	categories    *mgo.Collection
+	hosts         *mgo.Collection
+	apiKeys       *mgo.Collection
+	permissions   *mgo.Collection
 }
 
 // NewMongoStore returns an instance of MongoStore connected to a mongo database.
@@ -43,19 +105,209 @@ func NewMongoStore(uri, databaseName, username, password string) (*MongoStore, e
 
 	db := session.DB(databaseName)
 	events := db.C(EventsCollection)
+	s := &MongoStore{
+		db:            db,
+		events:        events,
+		registrations: db.C(RegistrationsCollection),
+		seats:         db.C(SeatsCollection),
+		audit:         db.C(AuditCollection),
+		feedTokens:    db.C(FeedTokensCollection),
+		series:        db.C(SeriesCollection),
+		categories:    db.C(CategoriesCollection),
+		hosts:         db.C(HostsCollection),
+		apiKeys:       db.C(APIKeysCollection),
+		permissions:   db.C(UserPermissionsCollection),
+	}
+	if err := s.migrateEventIDs(); err != nil {
+		return nil, errors.Wrapf(err, "could not migrate event IDs")
+	}
 	if err := events.EnsureIndex(mgo.Index{
 		Key:        []string{"id"},
-		Unique:     false,
+		Unique:     true,
 		Name:       EventsIndex,
 		Background: true,
 	}); err != nil {
 		return nil, errors.Wrapf(err, "could not ensure index")
 	}
+	if err := s.registrations.EnsureIndex(mgo.Index{
+		Key:        []string{"eventId", "userId"},
+		Unique:     true,
+		Name:       RegistrationsIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.seats.EnsureIndex(mgo.Index{
+		Key:        []string{"eventId"},
+		Unique:     true,
+		Name:       SeatsIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.audit.EnsureIndex(mgo.Index{
+		Key:        []string{"eventId", "id"},
+		Name:       AuditIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.registrations.EnsureIndex(mgo.Index{
+		Key:        []string{"userId"},
+		Name:       RegistrationsUserIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.feedTokens.EnsureIndex(mgo.Index{
+		Key:        []string{"userId"},
+		Unique:     true,
+		Name:       FeedTokensUserIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.feedTokens.EnsureIndex(mgo.Index{
+		Key:        []string{"tokenHash"},
+		Unique:     true,
+		Name:       FeedTokensHashIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.series.EnsureIndex(mgo.Index{
+		Key:        []string{"id"},
+		Unique:     true,
+		Name:       SeriesIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := events.EnsureIndex(mgo.Index{
+		Key:        []string{"start", "id"},
+		Name:       EventsStartIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := events.EnsureIndex(mgo.Index{
+		Key:        []string{"host", "start"},
+		Name:       EventsHostIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := events.EnsureIndex(mgo.Index{
+		Key:        []string{"tags", "start"},
+		Name:       EventsTagsIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.categories.EnsureIndex(mgo.Index{
+		Key:        []string{"id"},
+		Unique:     true,
+		Name:       CategoriesIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := events.EnsureIndex(mgo.Index{
+		Key:        []string{"hostId", "start"},
+		Name:       EventsHostIDIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := events.EnsureIndex(mgo.Index{
+		Key:        []string{"coHostIds", "start"},
+		Name:       EventsCoHostIDsIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.hosts.EnsureIndex(mgo.Index{
+		Key:        []string{"id"},
+		Unique:     true,
+		Name:       HostsIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.hosts.EnsureIndex(mgo.Index{
+		Key:        []string{"userId"},
+		Unique:     true,
+		Name:       HostsUserIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.apiKeys.EnsureIndex(mgo.Index{
+		Key:        []string{"id"},
+		Unique:     true,
+		Name:       APIKeysIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.apiKeys.EnsureIndex(mgo.Index{
+		Key:        []string{"keyHash"},
+		Unique:     true,
+		Name:       APIKeysHashIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.apiKeys.EnsureIndex(mgo.Index{
+		Key:        []string{"userId", "id"},
+		Name:       APIKeysUserIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := s.permissions.EnsureIndex(mgo.Index{
+		Key:        []string{"userId"},
+		Unique:     true,
+		Name:       UserPermissionsIndex,
+		Background: true,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
+	if err := events.EnsureIndex(eventsTextIndex()); err != nil {
+		return nil, errors.Wrapf(err, "could not ensure index")
+	}
 
-	return &MongoStore{
-		db:     db,
-		events: events,
-	}, nil
+	return s, nil
+}
+
+// migrateEventIDs assigns IDs to events that were stored without one and drops
+// the previous non-unique ID index, so that the unique index can be built.
+func (s *MongoStore) migrateEventIDs() error {
+	indexes, err := s.events.Indexes()
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if index.Name == EventsIndex && !index.Unique {
+			if err := s.events.DropIndexName(EventsIndex); err != nil {
+				return err
+			}
+		}
+	}
+
+	var result struct {
+		ObjectID bson.ObjectId `bson:"_id"`
+	}
+	missingID := bson.M{"$or": []bson.M{{"id": ""}, {"id": bson.M{"$exists": false}}}}
+	iter := s.events.Find(missingID).Select(bson.M{"_id": 1}).Iter()
+	for iter.Next(&result) {
+		if err := s.events.UpdateId(result.ObjectID, bson.M{"$set": bson.M{"id": NewID()}}); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+
+	return iter.Close()
 }
 
 // Name gets the name of this db implementation.
@@ -81,14 +333,211 @@ func (s *MongoStore) GetAllEvents() ([]Event, error) {
 	return results, nil
 }
 
-// CreateEvent adds a new event.
-func (s *MongoStore) CreateEvent(topic, description string) (*Event, error) {
-	event := &Event{
-		Topic:       topic,
-		Description: description,
-		Start:       time.Now(),
+// GetEventsBetween returns all events that overlap with the time between from and to.
+func (s *MongoStore) GetEventsBetween(from, to time.Time) ([]Event, error) {
+	var results []Event
+
+	query := bson.M{}
+	if !from.IsZero() {
+		query["end"] = bson.M{"$gt": from}
+	}
+	if !to.IsZero() {
+		query["start"] = bson.M{"$lt": to}
+	}
+	err := s.events.Find(query).Sort("start").All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ListEvents returns the events selected by the query in the requested order.
+func (s *MongoStore) ListEvents(query *EventQuery) ([]Event, error) {
+	var results []Event
+
+	sort := []string{"start", "id"}
+	if query.Order == SortDescending {
+		sort = []string{"-start", "-id"}
+	}
+	err := s.events.Find(eventFilter(query)).Sort(sort...).Limit(query.Limit).All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// CountTags returns the number of events selected by the query per tag.
+func (s *MongoStore) CountTags(query *EventQuery) ([]TagCount, error) {
+	var results []TagCount
+
+	err := s.events.Pipe([]bson.M{
+		{"$match": eventFilter(query)},
+		{"$unwind": "$tags"},
+		{"$group": bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}},
+	}).All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// eventFilter returns the MongoDB filter that selects the events of a query.
+func eventFilter(query *EventQuery) bson.M {
+	conditions := []bson.M{}
+	if !query.From.IsZero() {
+		conditions = append(conditions, bson.M{"end": bson.M{"$gt": query.From}})
+	}
+	if !query.To.IsZero() {
+		conditions = append(conditions, bson.M{"start": bson.M{"$lt": query.To}})
+	}
+	if query.Host != "" {
+		conditions = append(conditions, bson.M{"host": query.Host})
+	}
+	if query.HostID != "" {
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{"hostId": query.HostID},
+			{"coHostIds": query.HostID},
+		}})
+	}
+	if len(query.Statuses) > 0 {
+		conditions = append(conditions, bson.M{"status": bson.M{"$in": query.Statuses}})
+	}
+	if query.Published {
+		published := bson.M{"status": bson.M{"$nin": unpublishedStatuses}}
+		if query.OwnerID != "" {
+			published = bson.M{"$or": []bson.M{published, {"ownerId": query.OwnerID}}}
+		}
+		conditions = append(conditions, published)
+	}
+	if query.Language != "" {
+		conditions = append(conditions, bson.M{"language": query.Language})
+	}
+	if query.Tag != "" {
+		conditions = append(conditions, bson.M{"tags": query.Tag})
+	}
+	if query.CategoryID != "" {
+		conditions = append(conditions, bson.M{"categoryId": query.CategoryID})
+	}
+	if query.After != nil {
+		next := "$gt"
+		if query.Order == SortDescending {
+			next = "$lt"
+		}
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{"start": bson.M{next: query.After.Start}},
+			{"start": query.After.Start, "id": bson.M{next: query.After.ID}},
+		}})
+	}
+
+	if len(conditions) == 0 {
+		return bson.M{}
+	}
+	return bson.M{"$and": conditions}
+}
+
+// SearchEvents returns the events that match the query, ordered by relevance.
+func (s *MongoStore) SearchEvents(query *SearchQuery) ([]SearchResult, error) {
+	var results []SearchResult
+
+	selector := bson.M{"$text": bson.M{"$search": query.Text}}
+	if query.Published {
+		published := bson.M{"status": bson.M{"$nin": unpublishedStatuses}}
+		if query.OwnerID != "" {
+			selector["$or"] = []bson.M{published, {"ownerId": query.OwnerID}}
+		} else {
+			selector["status"] = published["status"]
+		}
+	}
+
+	err := s.events.Find(selector).
+		Select(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		Sort("$textScore:score", "id").
+		Limit(query.Limit).
+		All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// eventsTextIndex returns the text index of the searchable event fields.
+// Events are written in different languages, so terms are not stemmed. The language
+// of the event must not be used by MongoDB, because it doesn't know all language codes.
+func eventsTextIndex() mgo.Index {
+	index := mgo.Index{
+		Name:             EventsTextIndex,
+		Weights:          map[string]int{},
+		DefaultLanguage:  "none",
+		LanguageOverride: "textSearchLanguage",
+		Background:       true,
+	}
+	for field, weight := range EventSearchWeights {
+		index.Key = append(index.Key, "$text:"+field)
+		index.Weights[field] = weight
 	}
+	sort.Strings(index.Key)
+
+	return index
+}
+
+// GetEventByID returns the event or the occurrence of a series with the given ID.
+func (s *MongoStore) GetEventByID(id string) (*Event, error) {
+	if seriesID, originalStart, ok := ParseOccurrenceID(id); ok {
+		return getOccurrence(s, seriesID, originalStart)
+	}
+
+	var event Event
+
+	err := s.events.Find(bson.M{"id": id}).One(&event)
+	if err != nil {
+		return nil, convertError(err, id)
+	}
+
+	return &event, nil
+}
+
+// CreateEvent adds a new event and assigns it a new ID.
+func (s *MongoStore) CreateEvent(event *Event) (*Event, error) {
+	event.ID = NewID()
 	err := s.events.Insert(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
 
-	return event, err
+// UpdateEvent replaces the event with the same ID.
+func (s *MongoStore) UpdateEvent(event *Event) (*Event, error) {
+	err := s.events.Update(bson.M{"id": event.ID}, event)
+	if err != nil {
+		return nil, convertError(err, event.ID)
+	}
+
+	return event, nil
+}
+
+// DeleteEvent removes the event with the given ID.
+func (s *MongoStore) DeleteEvent(id string) error {
+	if err := s.events.Remove(bson.M{"id": id}); err != nil {
+		return convertError(err, id)
+	}
+	if _, err := s.registrations.RemoveAll(bson.M{"eventId": id}); err != nil {
+		return err
+	}
+	_, err := s.seats.RemoveAll(bson.M{"eventId": id})
+
+	return err
+}
+
+// convertError converts MongoDB specific errors into store errors.
+func convertError(err error, id string) error {
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("event", id)
+	}
+	return err
 }
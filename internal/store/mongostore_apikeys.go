@@ -0,0 +1,81 @@
+package store
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// CreateAPIKey stores a new API key.
+func (s *MongoStore) CreateAPIKey(key *APIKey) error {
+	return s.apiKeys.Insert(key)
+}
+
+// GetAPIKeyByHash returns the API key with the given hash.
+func (s *MongoStore) GetAPIKeyByHash(keyHash string) (*APIKey, error) {
+	var key APIKey
+
+	err := s.apiKeys.Find(bson.M{"keyHash": keyHash}).One(&key)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, NewNotFoundError("API key", keyHash)
+		}
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// ListAPIKeys returns the API keys of a user in the order they were created.
+func (s *MongoStore) ListAPIKeys(userID string) ([]APIKey, error) {
+	keys := []APIKey{}
+
+	err := s.apiKeys.Find(bson.M{"userId": userID}).Sort("id").All(&keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// UpdateAPIKeyLastUsed sets the time an API key was last used.
+func (s *MongoStore) UpdateAPIKeyLastUsed(id string, lastUsedAt time.Time) error {
+	err := s.apiKeys.Update(bson.M{"id": id}, bson.M{"$set": bson.M{"lastUsedAt": lastUsedAt}})
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("API key", id)
+	}
+
+	return err
+}
+
+// DeleteAPIKey removes the API key of a user.
+func (s *MongoStore) DeleteAPIKey(userID, id string) error {
+	err := s.apiKeys.Remove(bson.M{"id": id, "userId": userID})
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("API key", id)
+	}
+
+	return err
+}
+
+// SaveUserPermissions creates or replaces the permissions of a user.
+func (s *MongoStore) SaveUserPermissions(permissions *UserPermissions) error {
+	_, err := s.permissions.Upsert(bson.M{"userId": permissions.UserID}, permissions)
+	return err
+}
+
+// GetUserPermissions returns the permissions of a user.
+func (s *MongoStore) GetUserPermissions(userID string) (*UserPermissions, error) {
+	var permissions UserPermissions
+
+	err := s.permissions.Find(bson.M{"userId": userID}).One(&permissions)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, NewNotFoundError("user permissions", userID)
+		}
+		return nil, err
+	}
+
+	return &permissions, nil
+}
@@ -0,0 +1,30 @@
+package store
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+// RecordAuditEntry adds an entry to the audit log and assigns it a new ID.
+func (s *MongoStore) RecordAuditEntry(entry *AuditEntry) error {
+	entry.ID = NewID()
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	return s.audit.Insert(entry)
+}
+
+// ListAuditEntries returns all audit entries for an event in the order they were recorded.
+func (s *MongoStore) ListAuditEntries(eventID string) ([]AuditEntry, error) {
+	var results []AuditEntry
+
+	// IDs are ULIDs, so sorting by ID sorts by the time the entry was recorded.
+	err := s.audit.Find(bson.M{"eventId": eventID}).Sort("id").All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
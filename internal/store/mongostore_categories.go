@@ -0,0 +1,73 @@
+package store
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// GetAllCategories returns all categories ordered by name.
+func (s *MongoStore) GetAllCategories() ([]Category, error) {
+	var results []Category
+
+	err := s.categories.Find(nil).Sort("name").All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetCategoryByID returns the category with the given ID.
+func (s *MongoStore) GetCategoryByID(id string) (*Category, error) {
+	var category Category
+
+	err := s.categories.Find(bson.M{"id": id}).One(&category)
+	if err != nil {
+		return nil, convertCategoryError(err, id)
+	}
+
+	return &category, nil
+}
+
+// CreateCategory adds a new category and assigns it a new ID.
+func (s *MongoStore) CreateCategory(category *Category) (*Category, error) {
+	category.ID = NewID()
+	err := s.categories.Insert(category)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// UpdateCategory replaces the category with the same ID.
+func (s *MongoStore) UpdateCategory(category *Category) (*Category, error) {
+	err := s.categories.Update(bson.M{"id": category.ID}, category)
+	if err != nil {
+		return nil, convertCategoryError(err, category.ID)
+	}
+
+	return category, nil
+}
+
+// DeleteCategory removes the category with the given ID from the store and from all events and series.
+func (s *MongoStore) DeleteCategory(id string) error {
+	if err := s.categories.Remove(bson.M{"id": id}); err != nil {
+		return convertCategoryError(err, id)
+	}
+
+	if _, err := s.events.UpdateAll(bson.M{"categoryId": id}, bson.M{"$unset": bson.M{"categoryId": ""}}); err != nil {
+		return err
+	}
+	_, err := s.series.UpdateAll(bson.M{"event.categoryId": id}, bson.M{"$unset": bson.M{"event.categoryId": ""}})
+
+	return err
+}
+
+// convertCategoryError converts MongoDB specific errors into store errors.
+func convertCategoryError(err error, id string) error {
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("category", id)
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package store
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// SaveFeedToken stores the feed token of a user and replaces any previous token of the user.
+func (s *MongoStore) SaveFeedToken(token *FeedToken) error {
+	_, err := s.feedTokens.Upsert(bson.M{"userId": token.UserID}, token)
+	return err
+}
+
+// GetFeedTokenByHash returns the feed token with the given hash.
+func (s *MongoStore) GetFeedTokenByHash(tokenHash string) (*FeedToken, error) {
+	var token FeedToken
+
+	err := s.feedTokens.Find(bson.M{"tokenHash": tokenHash}).One(&token)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, NewNotFoundError("feed token", tokenHash)
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// DeleteFeedToken removes the feed token of a user.
+func (s *MongoStore) DeleteFeedToken(userID string) error {
+	err := s.feedTokens.Remove(bson.M{"userId": userID})
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("feed token", userID)
+	}
+
+	return err
+}
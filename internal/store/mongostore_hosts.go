@@ -0,0 +1,58 @@
+package store
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// GetHostByID returns the host with the given ID.
+func (s *MongoStore) GetHostByID(id string) (*Host, error) {
+	var host Host
+
+	err := s.hosts.Find(bson.M{"id": id}).One(&host)
+	if err != nil {
+		return nil, convertHostError(err, id)
+	}
+
+	return &host, nil
+}
+
+// GetHostByUserID returns the host profile of a user.
+func (s *MongoStore) GetHostByUserID(userID string) (*Host, error) {
+	var host Host
+
+	err := s.hosts.Find(bson.M{"userId": userID}).One(&host)
+	if err != nil {
+		return nil, convertHostError(err, userID)
+	}
+
+	return &host, nil
+}
+
+// SaveHost creates or replaces the host profile of a user.
+// The ID is only set when the profile is inserted, so that concurrent saves keep the same ID.
+func (s *MongoStore) SaveHost(host *Host) (*Host, error) {
+	_, err := s.hosts.Upsert(bson.M{"userId": host.UserID}, bson.M{
+		"$setOnInsert": bson.M{"id": NewID()},
+		"$set": bson.M{
+			"name":      host.Name,
+			"bio":       host.Bio,
+			"avatarUrl": host.AvatarURL,
+			"links":     host.Links,
+			"updatedAt": host.UpdatedAt,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetHostByUserID(host.UserID)
+}
+
+// convertHostError converts MongoDB specific errors into store errors.
+func convertHostError(err error, id string) error {
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("host", id)
+	}
+	return err
+}
@@ -0,0 +1,177 @@
+package store
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+var _ seatLedger = &MongoStore{}
+
+// RegisterForEvent registers a user for an event with the given capacity.
+//
+// The confirmed registrations of an event are counted in the seats collection. A seat is
+// claimed with a conditional increment, so concurrent registrations never exceed the capacity.
+func (s *MongoStore) RegisterForEvent(registration *Registration, capacity int) (*Registration, error) {
+	return registerWithSeats(s, registration, capacity)
+}
+
+// CancelRegistration removes the registration of a user for an event with the given capacity.
+func (s *MongoStore) CancelRegistration(eventID, userID string, capacity int) (*Registration, error) {
+	return cancelWithSeats(s, eventID, userID, capacity)
+}
+
+// FillSeats confirms waitlisted registrations of an event until the capacity is reached.
+// The claimed seats are reconciled with the confirmed registrations first.
+func (s *MongoStore) FillSeats(eventID string, capacity int) ([]Registration, error) {
+	if err := s.reconcileSeats(eventID); err != nil {
+		return nil, err
+	}
+
+	return fillSeats(s, eventID, capacity)
+}
+
+// GetRegistration returns the registration of a user for an event.
+func (s *MongoStore) GetRegistration(eventID, userID string) (*Registration, error) {
+	var registration Registration
+
+	err := s.registrations.Find(bson.M{"eventId": eventID, "userId": userID}).One(&registration)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, NewNotFoundError("registration", userID)
+		}
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+// ListRegistrations returns all registrations for an event in the order they were made.
+func (s *MongoStore) ListRegistrations(eventID string) ([]Registration, error) {
+	var results []Registration
+
+	err := s.registrations.Find(bson.M{"eventId": eventID}).Sort("createdAt").All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ListRegistrationsByUser returns all registrations of a user in the order they were made.
+func (s *MongoStore) ListRegistrationsByUser(userID string) ([]Registration, error) {
+	var results []Registration
+
+	err := s.registrations.Find(bson.M{"userId": userID}).Sort("createdAt").All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// claimSeat claims a seat of an event and returns false if the event is fully booked.
+func (s *MongoStore) claimSeat(eventID string, capacity int) (bool, error) {
+	_, err := s.seats.Upsert(bson.M{"eventId": eventID}, bson.M{"$setOnInsert": bson.M{"confirmed": 0}})
+	if err != nil && !mgo.IsDup(err) {
+		return false, err
+	}
+
+	selector := bson.M{"eventId": eventID}
+	if capacity > 0 {
+		selector["confirmed"] = bson.M{"$lt": capacity}
+	}
+	err = s.seats.Update(selector, bson.M{"$inc": bson.M{"confirmed": 1}})
+	if err == mgo.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// releaseSeat releases a claimed seat of an event.
+func (s *MongoStore) releaseSeat(eventID string) error {
+	return s.seats.Update(bson.M{"eventId": eventID}, bson.M{"$inc": bson.M{"confirmed": -1}})
+}
+
+// reconcileSeats corrects the number of claimed seats of an event if it is higher than the number of
+// confirmed registrations. The number is only replaced if it didn't change while the registrations were
+// counted, so that seats claimed in the meantime are kept.
+func (s *MongoStore) reconcileSeats(eventID string) error {
+	var seats struct {
+		Confirmed int `bson:"confirmed"`
+	}
+	err := s.seats.Find(bson.M{"eventId": eventID}).One(&seats)
+	if err == mgo.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	confirmed, err := s.registrations.Find(bson.M{"eventId": eventID, "status": RegistrationStatusConfirmed}).Count()
+	if err != nil {
+		return err
+	}
+	if confirmed >= seats.Confirmed {
+		return nil
+	}
+
+	err = s.seats.Update(bson.M{"eventId": eventID, "confirmed": seats.Confirmed}, bson.M{"$set": bson.M{"confirmed": confirmed}})
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// insertRegistration inserts a registration and returns false if the user is already registered.
+func (s *MongoStore) insertRegistration(registration *Registration) (bool, error) {
+	err := s.registrations.Insert(registration)
+	if mgo.IsDup(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// removeRegistration removes the registration of a user for an event.
+func (s *MongoStore) removeRegistration(eventID, userID string) (*Registration, error) {
+	var removed Registration
+	_, err := s.registrations.Find(bson.M{"eventId": eventID, "userId": userID}).
+		Apply(mgo.Change{Remove: true}, &removed)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, NewNotFoundError("registration", userID)
+		}
+		return nil, err
+	}
+
+	return &removed, nil
+}
+
+// confirmFirstWaitlisted confirms the waitlisted registration of an event that waited the longest.
+func (s *MongoStore) confirmFirstWaitlisted(eventID string) (*Registration, error) {
+	var promoted Registration
+	_, err := s.registrations.Find(bson.M{"eventId": eventID, "status": RegistrationStatusWaitlisted}).
+		Sort("createdAt").
+		Apply(mgo.Change{Update: bson.M{"$set": bson.M{"status": RegistrationStatusConfirmed}}, ReturnNew: true}, &promoted)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &promoted, nil
+}
+
+// hasWaitlisted returns true if a registration of the event is waitlisted.
+func (s *MongoStore) hasWaitlisted(eventID string) (bool, error) {
+	count, err := s.registrations.Find(bson.M{"eventId": eventID, "status": RegistrationStatusWaitlisted}).Limit(1).Count()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"regexp"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// GetAllSeries returns all series.
+func (s *MongoStore) GetAllSeries() ([]Series, error) {
+	var results []Series
+
+	err := s.series.Find(nil).All(&results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetSeriesByID returns the series with the given ID.
+func (s *MongoStore) GetSeriesByID(id string) (*Series, error) {
+	var series Series
+
+	err := s.series.Find(bson.M{"id": id}).One(&series)
+	if err != nil {
+		return nil, convertSeriesError(err, id)
+	}
+
+	return &series, nil
+}
+
+// CreateSeries adds a new series and assigns it a new ID.
+func (s *MongoStore) CreateSeries(series *Series) (*Series, error) {
+	series.ID = NewID()
+	err := s.series.Insert(series)
+	if err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// UpdateSeries replaces the series with the same ID.
+func (s *MongoStore) UpdateSeries(series *Series) (*Series, error) {
+	err := s.series.Update(bson.M{"id": series.ID}, series)
+	if err != nil {
+		return nil, convertSeriesError(err, series.ID)
+	}
+
+	return series, nil
+}
+
+// DeleteSeries removes the series with the given ID and the registrations for its occurrences.
+func (s *MongoStore) DeleteSeries(id string) error {
+	if err := s.series.Remove(bson.M{"id": id}); err != nil {
+		return convertSeriesError(err, id)
+	}
+
+	occurrences := bson.M{"eventId": bson.RegEx{Pattern: "^" + regexp.QuoteMeta(id+"_")}}
+	if _, err := s.registrations.RemoveAll(occurrences); err != nil {
+		return err
+	}
+	_, err := s.seats.RemoveAll(occurrences)
+
+	return err
+}
+
+// convertSeriesError converts MongoDB specific errors into store errors.
+func convertSeriesError(err error, id string) error {
+	if err == mgo.ErrNotFound {
+		return NewNotFoundError("series", id)
+	}
+	return err
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"time"
+)
+
+// SortOrder is the order in which events are listed.
+type SortOrder int
+
+const (
+	// SortAscending lists events by start time, earliest first.
+	SortAscending SortOrder = iota
+	// SortDescending lists events by start time, latest first.
+	SortDescending
+)
+
+// EventCursor is the position of the last event of a page. Events are ordered by their start time and ID.
+type EventCursor struct {
+	Start time.Time
+	ID    string
+}
+
+// EventQuery selects, orders and limits the events returned by ListEvents.
+// Empty fields don't filter the events.
+type EventQuery struct {
+	// From and To select the events that overlap with the time between them.
+	// A zero time means that the window is unbounded on that side.
+	From time.Time
+	To   time.Time
+
+	Host       string
+	Language   string
+	Tag        string
+	CategoryID string
+	// HostID selects the events of a host or co-host.
+	HostID string
+	// Statuses selects the events with any of the statuses.
+	Statuses []EventStatus
+	// Published selects only the events that are visible to the public.
+	Published bool
+	// OwnerID also selects the unpublished events of the user if only published events are selected.
+	OwnerID string
+
+	Order SortOrder
+	// After selects the events that follow the cursor in the sort order.
+	After *EventCursor
+	// Limit is the maximum number of events returned. Zero means no limit.
+	Limit int
+}
+
+// Matches returns true if the event is selected by the query.
+func (q *EventQuery) Matches(event *Event) bool {
+	if !event.Overlaps(q.From, q.To) {
+		return false
+	}
+	if q.Host != "" && event.Host != q.Host {
+		return false
+	}
+	if len(q.Statuses) > 0 && !containsStatus(q.Statuses, event.Status) {
+		return false
+	}
+	if q.HostID != "" && event.HostID != q.HostID && !contains(event.CoHostIDs, q.HostID) {
+		return false
+	}
+	if q.Published && !event.IsPublished() && (q.OwnerID == "" || event.OwnerID != q.OwnerID) {
+		return false
+	}
+	if q.Language != "" && event.Language != q.Language {
+		return false
+	}
+	if q.Tag != "" && !contains(event.Tags, q.Tag) {
+		return false
+	}
+	if q.CategoryID != "" && event.CategoryID != q.CategoryID {
+		return false
+	}
+	if q.After != nil && !q.Less(q.After.Start, q.After.ID, event) {
+		return false
+	}
+
+	return true
+}
+
+// Less returns true if an event with the given start time and ID is listed before the event.
+func (q *EventQuery) Less(start time.Time, id string, event *Event) bool {
+	if start.Equal(event.Start) {
+		if q.Order == SortDescending {
+			return id > event.ID
+		}
+		return id < event.ID
+	}
+	if q.Order == SortDescending {
+		return start.After(event.Start)
+	}
+	return start.Before(event.Start)
+}
+
+// Cursor returns the cursor that lists the events after the given event.
+func (e *Event) Cursor() *EventCursor {
+	return &EventCursor{Start: e.Start, ID: e.ID}
+}
+
+// containsStatus returns true if the status is one of the statuses.
+func containsStatus(statuses []EventStatus, status EventStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// contains returns true if the value is one of the values.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
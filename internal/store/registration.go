@@ -0,0 +1,43 @@
+package store
+
+import "time"
+
+// RegistrationStatus is the status of a registration for an event.
+type RegistrationStatus string
+
+const (
+	// RegistrationStatusConfirmed is the status of a registration that has a seat.
+	RegistrationStatusConfirmed RegistrationStatus = "confirmed"
+	// RegistrationStatusWaitlisted is the status of a registration that is waiting for a free seat.
+	RegistrationStatusWaitlisted RegistrationStatus = "waitlisted"
+)
+
+// Registration is the registration of a user for an event.
+type Registration struct {
+	ID        string             `bson:"id"`
+	EventID   string             `bson:"eventId"`
+	UserID    string             `bson:"userId"`
+	UserName  string             `bson:"userName"`
+	Status    RegistrationStatus `bson:"status"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+// RegistrationStore is implemented by all stores that persist registrations.
+type RegistrationStore interface {
+	// RegisterForEvent registers a user for an event with the given capacity, where zero means unlimited.
+	// The registration is confirmed if a seat is free and waitlisted otherwise.
+	// If the user is already registered, the existing registration is returned.
+	RegisterForEvent(registration *Registration, capacity int) (*Registration, error)
+	// CancelRegistration removes the registration of a user for an event with the given capacity or returns a NotFoundError.
+	// If the registration was confirmed, the first waitlisted registration is confirmed and returned.
+	CancelRegistration(eventID, userID string, capacity int) (*Registration, error)
+	// FillSeats confirms waitlisted registrations of an event in the order they were made until the
+	// capacity is reached, for example after the capacity was raised, and returns them.
+	FillSeats(eventID string, capacity int) ([]Registration, error)
+	// GetRegistration returns the registration of a user for an event or a NotFoundError.
+	GetRegistration(eventID, userID string) (*Registration, error)
+	// ListRegistrations returns all registrations for an event in the order they were made.
+	ListRegistrations(eventID string) ([]Registration, error)
+	// ListRegistrationsByUser returns all registrations of a user in the order they were made.
+	ListRegistrationsByUser(userID string) ([]Registration, error)
+}
@@ -0,0 +1,43 @@
+package store
+
+// EventSearchWeights are the weights of the searchable fields of events.
+// Matches in the topic count more than matches in the host name or description.
+var EventSearchWeights = map[string]int{
+	"topic":       10,
+	"host":        5,
+	"description": 1,
+}
+
+// SearchQuery selects and limits the events returned by SearchEvents.
+type SearchQuery struct {
+	// Text is the search query. Events that contain any of its terms match.
+	Text string
+	// Published selects only the events that are visible to the public.
+	Published bool
+	// OwnerID also selects the unpublished events of the user if only published events are selected.
+	OwnerID string
+	// Limit is the maximum number of results. Zero means no limit.
+	Limit int
+}
+
+// Matches returns true if the visibility of the event is selected by the query.
+// The text is matched by the search index of the store.
+func (q *SearchQuery) Matches(event *Event) bool {
+	return !q.Published || event.IsPublished() || (q.OwnerID != "" && event.OwnerID == q.OwnerID)
+}
+
+// SearchResult is an event that matches a search query.
+type SearchResult struct {
+	Event Event `bson:",inline"`
+	// Score is the relevance of the event. Results with a higher score match better.
+	Score float64 `bson:"score"`
+}
+
+// searchFields returns the searchable fields of an event.
+func searchFields(event *Event) map[string]string {
+	return map[string]string{
+		"topic":       event.Topic,
+		"host":        event.Host,
+		"description": event.Description,
+	}
+}
@@ -0,0 +1,144 @@
+package store
+
+import "time"
+
+// seatLedger is the storage of registrations that counts the confirmed registrations per event in seats.
+// The registration workflow of stores without transactions is built on these operations, each of which is atomic.
+type seatLedger interface {
+	// claimSeat claims a seat of an event and returns false if the event is fully booked.
+	claimSeat(eventID string, capacity int) (bool, error)
+	// releaseSeat releases a claimed seat of an event.
+	releaseSeat(eventID string) error
+	// reconcileSeats corrects the number of claimed seats of an event if it is higher than the number of
+	// confirmed registrations, for example because a seat couldn't be released after a failed registration.
+	reconcileSeats(eventID string) error
+	// insertRegistration inserts a registration and returns false if the user is already registered.
+	insertRegistration(registration *Registration) (bool, error)
+	// removeRegistration removes the registration of a user for an event or returns a NotFoundError.
+	removeRegistration(eventID, userID string) (*Registration, error)
+	// confirmFirstWaitlisted confirms the waitlisted registration of an event that waited the longest.
+	// It returns nil if no registration is waitlisted.
+	confirmFirstWaitlisted(eventID string) (*Registration, error)
+	// hasWaitlisted returns true if a registration of the event is waitlisted.
+	hasWaitlisted(eventID string) (bool, error)
+	// GetRegistration returns the registration of a user for an event or a NotFoundError.
+	GetRegistration(eventID, userID string) (*Registration, error)
+}
+
+// registerWithSeats registers a user for an event. The registration is confirmed if a seat can be claimed.
+//
+// A registration that didn't get a seat is inserted as waitlisted. A cancellation between the failed
+// claim and the insert frees a seat without seeing the new registration, so the free seats are handed
+// to the waitlist once the registration is on it.
+func registerWithSeats(ledger seatLedger, registration *Registration, capacity int) (*Registration, error) {
+	existing, err := ledger.GetRegistration(registration.EventID, registration.UserID)
+	if err == nil {
+		return existing, nil
+	} else if !IsNotFound(err) {
+		return nil, err
+	}
+
+	confirmed, err := ledger.claimSeat(registration.EventID, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	registration.ID = NewID()
+	registration.CreatedAt = time.Now()
+	registration.Status = RegistrationStatusWaitlisted
+	if confirmed {
+		registration.Status = RegistrationStatusConfirmed
+	}
+
+	inserted, err := ledger.insertRegistration(registration)
+	if err != nil || !inserted {
+		if confirmed {
+			if releaseErr := ledger.releaseSeat(registration.EventID); releaseErr != nil {
+				return nil, releaseErr
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The user registered concurrently, return that registration instead.
+		return ledger.GetRegistration(registration.EventID, registration.UserID)
+	}
+	if confirmed {
+		return registration, nil
+	}
+
+	if _, err := fillSeats(ledger, registration.EventID, capacity); err != nil {
+		return nil, err
+	}
+
+	return ledger.GetRegistration(registration.EventID, registration.UserID)
+}
+
+// cancelWithSeats removes the registration of a user for an event. If the registration was confirmed,
+// its seat is handed to the first waitlisted registration, which is returned.
+func cancelWithSeats(ledger seatLedger, eventID, userID string, capacity int) (*Registration, error) {
+	cancelled, err := ledger.removeRegistration(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled.Status != RegistrationStatusConfirmed {
+		return nil, nil
+	}
+
+	promoted, err := handOverSeat(ledger, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A registration that was waitlisted after the seat was handed over may have missed the free seat.
+	filled, err := fillSeats(ledger, eventID, capacity)
+	if err != nil {
+		return nil, err
+	}
+	if promoted == nil && len(filled) > 0 {
+		promoted = &filled[0]
+	}
+
+	return promoted, nil
+}
+
+// fillSeats hands the free seats of an event to the waitlisted registrations in the order they were made,
+// until no seat is free or no registration is waitlisted, and returns the confirmed registrations.
+//
+// The waitlist is checked again after a seat was released, because a registration may have been
+// waitlisted after the seat was claimed and then failed to claim a seat itself.
+func fillSeats(ledger seatLedger, eventID string, capacity int) ([]Registration, error) {
+	var promoted []Registration
+	for {
+		waitlisted, err := ledger.hasWaitlisted(eventID)
+		if err != nil || !waitlisted {
+			return promoted, err
+		}
+
+		claimed, err := ledger.claimSeat(eventID, capacity)
+		if err != nil || !claimed {
+			return promoted, err
+		}
+		registration, err := handOverSeat(ledger, eventID)
+		if err != nil {
+			return promoted, err
+		}
+		if registration != nil {
+			promoted = append(promoted, *registration)
+		}
+	}
+}
+
+// handOverSeat hands a claimed seat to the first waitlisted registration and returns it.
+// If no registration is waitlisted, the seat is released.
+func handOverSeat(ledger seatLedger, eventID string) (*Registration, error) {
+	promoted, err := ledger.confirmFirstWaitlisted(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if promoted == nil {
+		return nil, ledger.releaseSeat(eventID)
+	}
+
+	return promoted, nil
+}
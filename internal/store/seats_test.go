@@ -0,0 +1,208 @@
+package store
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeSeatLedger is an in-memory seat ledger for a single event.
+// The afterClaimSeat and beforeReleaseSeat hooks run once, to interleave concurrent operations.
+type fakeSeatLedger struct {
+	confirmed         int
+	registrations     []Registration
+	afterClaimSeat    func(claimed bool)
+	beforeReleaseSeat func()
+	insertErr         error
+	releaseErr        error
+}
+
+func (l *fakeSeatLedger) claimSeat(eventID string, capacity int) (bool, error) {
+	claimed := capacity == 0 || l.confirmed < capacity
+	if claimed {
+		l.confirmed++
+	}
+	if hook := l.afterClaimSeat; hook != nil {
+		l.afterClaimSeat = nil
+		hook(claimed)
+	}
+	return claimed, nil
+}
+
+func (l *fakeSeatLedger) releaseSeat(eventID string) error {
+	if hook := l.beforeReleaseSeat; hook != nil {
+		l.beforeReleaseSeat = nil
+		hook()
+	}
+	if l.releaseErr != nil {
+		return l.releaseErr
+	}
+	l.confirmed--
+	return nil
+}
+
+func (l *fakeSeatLedger) reconcileSeats(eventID string) error {
+	confirmed := 0
+	for _, registration := range l.registrations {
+		if registration.Status == RegistrationStatusConfirmed {
+			confirmed++
+		}
+	}
+	if confirmed < l.confirmed {
+		l.confirmed = confirmed
+	}
+	return nil
+}
+
+func (l *fakeSeatLedger) insertRegistration(registration *Registration) (bool, error) {
+	if l.insertErr != nil {
+		return false, l.insertErr
+	}
+	for _, existing := range l.registrations {
+		if existing.UserID == registration.UserID {
+			return false, nil
+		}
+	}
+	l.registrations = append(l.registrations, *registration)
+	return true, nil
+}
+
+func (l *fakeSeatLedger) removeRegistration(eventID, userID string) (*Registration, error) {
+	for i, existing := range l.registrations {
+		if existing.UserID == userID {
+			l.registrations = append(l.registrations[:i], l.registrations[i+1:]...)
+			return &existing, nil
+		}
+	}
+	return nil, NewNotFoundError("registration", userID)
+}
+
+func (l *fakeSeatLedger) confirmFirstWaitlisted(eventID string) (*Registration, error) {
+	for i := range l.registrations {
+		if l.registrations[i].Status == RegistrationStatusWaitlisted {
+			l.registrations[i].Status = RegistrationStatusConfirmed
+			promoted := l.registrations[i]
+			return &promoted, nil
+		}
+	}
+	return nil, nil
+}
+
+func (l *fakeSeatLedger) hasWaitlisted(eventID string) (bool, error) {
+	for _, registration := range l.registrations {
+		if registration.Status == RegistrationStatusWaitlisted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (l *fakeSeatLedger) GetRegistration(eventID, userID string) (*Registration, error) {
+	for _, existing := range l.registrations {
+		if existing.UserID == userID {
+			return &existing, nil
+		}
+	}
+	return nil, NewNotFoundError("registration", userID)
+}
+
+var _ = Describe("Seat ledger registrations", func() {
+	var ledger *fakeSeatLedger
+
+	BeforeEach(func() {
+		ledger = &fakeSeatLedger{}
+		_, err := registerWithSeats(ledger, &Registration{EventID: "event", UserID: "alice"}, 1)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("when a registration is cancelled before a waitlisted registration is inserted", func() {
+		It("should confirm the new registration", func() {
+			ledger.afterClaimSeat = func(claimed bool) {
+				Expect(claimed).To(BeFalse())
+				promoted, err := cancelWithSeats(ledger, "event", "alice", 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(promoted).To(BeNil())
+			}
+
+			registration, err := registerWithSeats(ledger, &Registration{EventID: "event", UserID: "bob"}, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(registration.Status).To(Equal(RegistrationStatusConfirmed))
+			Expect(ledger.confirmed).To(Equal(1))
+		})
+	})
+
+	Describe("when a registration is cancelled after a waitlisted registration is inserted", func() {
+		It("should confirm the waitlisted registration once", func() {
+			_, err := registerWithSeats(ledger, &Registration{EventID: "event", UserID: "bob"}, 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			promoted, err := cancelWithSeats(ledger, "event", "alice", 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted.UserID).To(Equal("bob"))
+			Expect(ledger.confirmed).To(Equal(1))
+		})
+	})
+
+	Describe("when a registration is waitlisted while a cancelled registration releases its seat", func() {
+		It("should confirm the waitlisted registration", func() {
+			var registration *Registration
+			ledger.beforeReleaseSeat = func() {
+				var err error
+				registration, err = registerWithSeats(ledger, &Registration{EventID: "event", UserID: "bob"}, 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(registration.Status).To(Equal(RegistrationStatusWaitlisted))
+			}
+
+			promoted, err := cancelWithSeats(ledger, "event", "alice", 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted.UserID).To(Equal("bob"))
+			Expect(ledger.confirmed).To(Equal(1))
+			registration, err = ledger.GetRegistration("event", "bob")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(registration.Status).To(Equal(RegistrationStatusConfirmed))
+		})
+	})
+
+	Describe("when the seat of a failed registration can't be released", func() {
+		It("should return the error", func() {
+			_, err := cancelWithSeats(ledger, "event", "alice", 1)
+			Expect(err).ToNot(HaveOccurred())
+			ledger.insertErr = errors.New("insert failed")
+			ledger.releaseErr = errors.New("release failed")
+
+			_, err = registerWithSeats(ledger, &Registration{EventID: "event", UserID: "bob"}, 1)
+
+			Expect(err).To(MatchError("release failed"))
+		})
+	})
+
+	Describe("when more seats are claimed than registrations are confirmed", func() {
+		It("should reconcile the seats before filling them", func() {
+			_, err := registerWithSeats(ledger, &Registration{EventID: "event", UserID: "bob"}, 1)
+			Expect(err).ToNot(HaveOccurred())
+			ledger.confirmed = 2
+
+			Expect(ledger.reconcileSeats("event")).To(Succeed())
+			promoted, err := fillSeats(ledger, "event", 2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted).To(HaveLen(1))
+			Expect(promoted[0].UserID).To(Equal("bob"))
+			Expect(ledger.confirmed).To(Equal(2))
+		})
+	})
+
+	Describe("when the event is fully booked", func() {
+		It("should keep the registration on the waitlist without claiming a seat", func() {
+			registration, err := registerWithSeats(ledger, &Registration{EventID: "event", UserID: "bob"}, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(registration.Status).To(Equal(RegistrationStatusWaitlisted))
+			Expect(ledger.confirmed).To(Equal(1))
+		})
+	})
+})
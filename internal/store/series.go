@@ -0,0 +1,203 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sebastianrosch/couchconnections/internal/recurrence"
+)
+
+// occurrenceIDFormat is the format of the original start time in occurrence IDs.
+const occurrenceIDFormat = "20060102T150405Z"
+
+// Series is a recurring event. Only the series and its exceptions are stored,
+// the occurrences are calculated from the recurrence rule when they are read.
+type Series struct {
+	ID string `bson:"id"`
+	// Event is the template of all occurrences. Its start and end are those of the first occurrence.
+	Event Event `bson:"event"`
+	// Recurrence is the recurrence rule as defined in RFC 5545, e.g. "FREQ=WEEKLY;BYDAY=WE".
+	Recurrence string `bson:"recurrence"`
+	// Exceptions are the occurrences that were moved, changed or cancelled.
+	Exceptions []SeriesException `bson:"exceptions"`
+}
+
+// SeriesException replaces or cancels a single occurrence of a series.
+type SeriesException struct {
+	// OriginalStart is the start time of the occurrence as calculated from the recurrence rule.
+	OriginalStart time.Time `bson:"originalStart"`
+	// Cancelled is true if the occurrence was cancelled.
+	Cancelled bool `bson:"cancelled"`
+	// Event replaces the occurrence if it was changed.
+	Event *Event `bson:"event,omitempty"`
+}
+
+// SeriesStore is implemented by all stores that persist event series.
+type SeriesStore interface {
+	// GetAllSeries returns all series.
+	GetAllSeries() ([]Series, error)
+	// GetSeriesByID returns the series with the given ID or a NotFoundError.
+	GetSeriesByID(id string) (*Series, error)
+	// CreateSeries adds a new series and assigns it a new ID.
+	CreateSeries(series *Series) (*Series, error)
+	// UpdateSeries replaces the series with the same ID or returns a NotFoundError.
+	UpdateSeries(series *Series) (*Series, error)
+	// DeleteSeries removes the series with the given ID and the registrations for its occurrences
+	// or returns a NotFoundError.
+	DeleteSeries(id string) error
+}
+
+// OccurrenceID returns the ID of the occurrence of a series with the given original start time.
+func OccurrenceID(seriesID string, originalStart time.Time) string {
+	return seriesID + "_" + originalStart.UTC().Format(occurrenceIDFormat)
+}
+
+// ParseOccurrenceID returns the series ID and original start time of an occurrence ID.
+// It returns false if the ID is not the ID of an occurrence.
+func ParseOccurrenceID(id string) (string, time.Time, bool) {
+	i := strings.LastIndex(id, "_")
+	if i < 1 {
+		return "", time.Time{}, false
+	}
+	originalStart, err := time.Parse(occurrenceIDFormat, id[i+1:])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+
+	return id[:i], originalStart, true
+}
+
+// Rule returns the parsed recurrence rule of the series.
+func (s *Series) Rule() (*recurrence.Rule, error) {
+	return recurrence.Parse(s.Recurrence)
+}
+
+// Start returns the start time of the first occurrence in the time zone of the series.
+func (s *Series) Start() time.Time {
+	location, err := time.LoadLocation(s.Event.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	return s.Event.Start.In(location)
+}
+
+// Occurrence returns the occurrence with the given original start time or a NotFoundError.
+func (s *Series) Occurrence(originalStart time.Time) (*Event, error) {
+	rule, err := s.Rule()
+	if err != nil {
+		return nil, err
+	}
+	if !rule.Includes(s.Start(), originalStart) {
+		return nil, NewNotFoundError("event", OccurrenceID(s.ID, originalStart))
+	}
+
+	event := s.occurrence(originalStart)
+	return &event, nil
+}
+
+// Occurrences returns all occurrences that overlap with the time between from and to, ordered by start time.
+// Cancelled occurrences are returned with the cancelled status. As series can be infinite, to must be set.
+func (s *Series) Occurrences(from, to time.Time) ([]Event, error) {
+	rule, err := s.Rule()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Event
+	seen := map[string]bool{}
+	for _, start := range rule.Between(s.Start(), from.Add(-s.Event.Duration()), to) {
+		event := s.occurrence(start)
+		seen[event.ID] = true
+		if event.Overlaps(from, to) {
+			results = append(results, event)
+		}
+	}
+
+	// Occurrences can be moved into the window from outside.
+	for _, exception := range s.Exceptions {
+		id := OccurrenceID(s.ID, exception.OriginalStart)
+		if seen[id] || exception.Event == nil {
+			continue
+		}
+		event := s.occurrence(exception.OriginalStart)
+		if event.Overlaps(from, to) && rule.Includes(s.Start(), exception.OriginalStart) {
+			results = append(results, event)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Start.Before(results[j].Start)
+	})
+
+	return results, nil
+}
+
+// SetException adds or replaces the exception for the occurrence with the given original start time.
+func (s *Series) SetException(exception SeriesException) {
+	for i := range s.Exceptions {
+		if s.Exceptions[i].OriginalStart.Equal(exception.OriginalStart) {
+			s.Exceptions[i] = exception
+			return
+		}
+	}
+	s.Exceptions = append(s.Exceptions, exception)
+}
+
+// occurrence returns the occurrence with the given original start time with its exception applied.
+func (s *Series) occurrence(originalStart time.Time) Event {
+	event := s.Event
+	event.Start = originalStart
+	event.End = originalStart.Add(s.Event.Duration())
+
+	for _, exception := range s.Exceptions {
+		if !exception.OriginalStart.Equal(originalStart) {
+			continue
+		}
+		if exception.Event != nil {
+			event = *exception.Event
+		}
+		if exception.Cancelled {
+			event.Status = EventStatusCancelled
+		}
+	}
+
+	event.ID = OccurrenceID(s.ID, originalStart)
+	event.SeriesID = s.ID
+	event.Recurrence = s.Recurrence
+	event.OwnerID = s.Event.OwnerID
+	return event
+}
+
+// getOccurrence returns the occurrence of a series or a NotFoundError if the series or the occurrence doesn't exist.
+func getOccurrence(store SeriesStore, seriesID string, originalStart time.Time) (*Event, error) {
+	series, err := store.GetSeriesByID(seriesID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, NewNotFoundError("event", OccurrenceID(seriesID, originalStart))
+		}
+		return nil, err
+	}
+
+	return series.Occurrence(originalStart)
+}
+
+// GetOccurrences returns the occurrences of all series in the store that overlap with the time between from and to.
+// As series can be infinite, to must be set.
+func GetOccurrences(store SeriesStore, from, to time.Time) ([]Event, error) {
+	allSeries, err := store.GetAllSeries()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Event
+	for i := range allSeries {
+		occurrences, err := allSeries[i].Occurrences(from, to)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, occurrences...)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Series", func() {
+	var series *Series
+	var start time.Time
+
+	BeforeEach(func() {
+		start = time.Date(2020, 4, 1, 18, 0, 0, 0, time.UTC)
+		series = &Series{
+			ID:         "series",
+			Event:      Event{Topic: "Remote work", Start: start, End: start.Add(time.Hour), TimeZone: "UTC"},
+			Recurrence: "FREQ=WEEKLY",
+		}
+	})
+
+	Describe("when occurrences are calculated", func() {
+		It("should return the occurrences that overlap with the window", func() {
+			occurrences, err := series.Occurrences(start.Add(30*time.Minute), start.AddDate(0, 0, 14))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(occurrences).To(HaveLen(2))
+			Expect(occurrences[0].ID).To(Equal("series_20200401T180000Z"))
+			Expect(occurrences[0].SeriesID).To(Equal("series"))
+			Expect(occurrences[1].Start).To(Equal(start.AddDate(0, 0, 7)))
+		})
+
+		It("should apply cancelled and moved occurrences", func() {
+			moved := series.Event
+			moved.Start = start.AddDate(0, 0, 30)
+			moved.End = moved.Start.Add(time.Hour)
+			series.SetException(SeriesException{OriginalStart: start, Cancelled: true})
+			series.SetException(SeriesException{OriginalStart: start.AddDate(0, 0, 7), Event: &moved})
+
+			occurrences, err := series.Occurrences(start, start.AddDate(0, 0, 14))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(occurrences).To(HaveLen(1))
+			Expect(occurrences[0].Status).To(Equal(EventStatusCancelled))
+
+			occurrences, err = series.Occurrences(start.AddDate(0, 0, 29), start.AddDate(0, 0, 32))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(occurrences).To(HaveLen(1))
+			Expect(occurrences[0].ID).To(Equal("series_20200408T180000Z"))
+			Expect(occurrences[0].Start).To(Equal(moved.Start))
+		})
+	})
+
+	Describe("when an occurrence ID is parsed", func() {
+		It("should return the series ID and the original start", func() {
+			seriesID, originalStart, ok := ParseOccurrenceID(OccurrenceID("01E4Y3X6J5", start))
+
+			Expect(ok).To(BeTrue())
+			Expect(seriesID).To(Equal("01E4Y3X6J5"))
+			Expect(originalStart).To(Equal(start))
+		})
+
+		It("should not accept event IDs", func() {
+			_, _, ok := ParseOccurrenceID("01E4Y3X6J5")
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("when an occurrence is read from the store", func() {
+		It("should be resolved from its series", func() {
+			store := NewMemoryStore()
+			created, err := store.CreateSeries(series)
+			Expect(err).ToNot(HaveOccurred())
+
+			event, err := store.GetEventByID(OccurrenceID(created.ID, start.AddDate(0, 0, 7)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Topic).To(Equal("Remote work"))
+
+			_, err = store.GetEventByID(OccurrenceID(created.ID, start.AddDate(0, 0, 8)))
+			Expect(IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
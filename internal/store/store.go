@@ -0,0 +1,143 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NotFoundError is returned when the requested item does not exist in the store.
+type NotFoundError struct {
+	kind string
+	id   string
+}
+
+// Error returns the message of the NotFoundError.
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.kind, e.id)
+}
+
+// NewNotFoundError returns a new instance of NotFoundError for the given kind of item and ID.
+func NewNotFoundError(kind, id string) *NotFoundError {
+	return &NotFoundError{kind: kind, id: id}
+}
+
+// IsNotFound returns true if the error is a NotFoundError.
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// EventStatus is the status of an event.
+type EventStatus string
+
+const (
+	// EventStatusDraft is the status of an event that is not yet scheduled.
+	EventStatusDraft EventStatus = "draft"
+	// EventStatusScheduled is the status of a scheduled event.
+	EventStatusScheduled EventStatus = "scheduled"
+	// EventStatusLive is the status of an event that is currently live.
+	EventStatusLive EventStatus = "live"
+	// EventStatusFinished is the status of an event that has finished.
+	EventStatusFinished EventStatus = "finished"
+	// EventStatusCancelled is the status of a cancelled event.
+	EventStatusCancelled EventStatus = "cancelled"
+	// EventStatusPending is the status of a submitted event that waits for the review of an admin.
+	EventStatusPending EventStatus = "pending"
+	// EventStatusRejected is the status of a submitted event that was rejected by an admin.
+	EventStatusRejected EventStatus = "rejected"
+)
+
+// unpublishedStatuses are the statuses of events that are not visible to the public.
+var unpublishedStatuses = []EventStatus{EventStatusDraft, EventStatusPending, EventStatusRejected}
+
+// Event is an event hosted in a living room.
+type Event struct {
+	ID          string      `bson:"id"`
+	Topic       string      `bson:"topic"`
+	Description string      `bson:"description"`
+	Host        string      `bson:"host"`
+	ZoomLink    string      `bson:"zoomLink"`
+	Start       time.Time   `bson:"start"`
+	End         time.Time   `bson:"end"`
+	TimeZone    string      `bson:"timeZone"`
+	Language    string      `bson:"language"`
+	Capacity    int         `bson:"capacity"`
+	Status      EventStatus `bson:"status"`
+	OwnerID     string      `bson:"ownerId"`
+	Tags        []string    `bson:"tags,omitempty"`
+	CategoryID  string      `bson:"categoryId,omitempty"`
+	// HostID and CoHostIDs reference the profiles of the hosts. Host is the display name of the host.
+	HostID    string   `bson:"hostId,omitempty"`
+	CoHostIDs []string `bson:"coHostIds,omitempty"`
+	// RejectionReason is the reason an admin gave for rejecting the event.
+	RejectionReason string `bson:"rejectionReason,omitempty"`
+	// SeriesID and Recurrence are only set on occurrences of a series.
+	SeriesID   string `bson:"seriesId,omitempty"`
+	Recurrence string `bson:"recurrence,omitempty"`
+}
+
+// Duration returns the duration of the event.
+func (e *Event) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// IsPublished returns true if the event is visible to the public. Drafts and events
+// that were not approved by an admin are only visible to their owner and to admins.
+func (e *Event) IsPublished() bool {
+	for _, status := range unpublishedStatuses {
+		if e.Status == status {
+			return false
+		}
+	}
+	return true
+}
+
+// Overlaps returns true if the event overlaps with the time between from and to.
+// A zero time means that the window is unbounded on that side.
+func (e *Event) Overlaps(from, to time.Time) bool {
+	return (from.IsZero() || e.End.After(from)) && (to.IsZero() || e.Start.Before(to))
+}
+
+// Store is implemented by all store implementations and combines the stores of all resources.
+type Store interface {
+	EventStore
+	RegistrationStore
+	AuditStore
+	FeedTokenStore
+	SeriesStore
+	CategoryStore
+	HostStore
+	APIKeyStore
+}
+
+// EventStore is implemented by all stores that persist events.
+type EventStore interface {
+	// Name gets the name of the store implementation.
+	Name() string
+	// CheckReadiness checks the readiness of the store and returns an error if it's not ready.
+	CheckReadiness() error
+
+	// GetAllEvents returns all events.
+	GetAllEvents() ([]Event, error)
+	// GetEventsBetween returns all events that overlap with the time between from and to.
+	// Occurrences of series are not included.
+	GetEventsBetween(from, to time.Time) ([]Event, error)
+	// ListEvents returns the events selected by the query in the requested order.
+	// Occurrences of series are not included.
+	ListEvents(query *EventQuery) ([]Event, error)
+	// CountTags returns the number of events selected by the query per tag, in any order.
+	// The order, cursor and limit of the query are ignored. Occurrences of series are not included.
+	CountTags(query *EventQuery) ([]TagCount, error)
+	// SearchEvents returns the events selected by the query whose topic, host or description contain
+	// any of the terms of the query text, ordered by relevance. Occurrences of series are not included.
+	SearchEvents(query *SearchQuery) ([]SearchResult, error)
+	// GetEventByID returns the event with the given ID or a NotFoundError.
+	GetEventByID(id string) (*Event, error)
+	// CreateEvent adds a new event and assigns it a new ID.
+	CreateEvent(event *Event) (*Event, error)
+	// UpdateEvent replaces the event with the same ID or returns a NotFoundError.
+	UpdateEvent(event *Event) (*Event, error)
+	// DeleteEvent removes the event with the given ID or returns a NotFoundError.
+	DeleteEvent(id string) error
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// APIKeyPrefix is the beginning of every API key, which distinguishes API keys from access tokens
+const APIKeyPrefix = "cck_"
+
+// apiKeyHeader is the metadata key of the API key header
+const apiKeyHeader = "x-api-key"
+
+// IsAPIKey returns true if the token is an API key
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// APIKeyIdentity is the user and the permissions of a valid API key
+type APIKeyIdentity struct {
+	ID          string
+	UserID      string
+	UserName    string
+	Permissions []string
+}
+
+// APIKeyResolver validates API keys
+type APIKeyResolver interface {
+	// ResolveAPIKey returns the identity of a valid API key or an InvalidAPIKeyError
+	// The permissions of the identity are limited to the recorded permissions of the user of the key.
+	ResolveAPIKey(ctx context.Context, key string) (*APIKeyIdentity, error)
+	// RecordPermissions records the current permissions of a user who authenticated with an access token
+	RecordPermissions(ctx context.Context, userID string, permissions []string) error
+}
+
+// APIKeyTokenContext returns the bearer token of the authorization header like
+// [BearerTokenContext](#type-bearertokencontext), or the API key of the x-api-key header if there is no authorization header.
+// API keys can be sent in either header.
+type APIKeyTokenContext struct {
+	BearerTokenContext
+}
+
+// GetAuthTokenFromAuthorizationHeader returns the auth token from the authorization header or the API key header
+func (a *APIKeyTokenContext) GetAuthTokenFromAuthorizationHeader(ctx context.Context) string {
+	if token := a.BearerTokenContext.GetAuthTokenFromAuthorizationHeader(ctx); token != "" {
+		return token
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(apiKeyHeader)) != 0 {
+		return md.Get(apiKeyHeader)[0]
+	}
+
+	return ""
+}
+
+type apiKeyIDKey struct{}
+
+// WithAPIKeyID adds the ID of the API key that authenticated the request to the context
+func WithAPIKeyID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, apiKeyIDKey{}, id)
+}
+
+// GetAPIKeyIDFromContext returns the ID of the API key that authenticated the request
+// or an empty string if the request was not authenticated with an API key
+func GetAPIKeyIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(apiKeyIDKey{}).(string)
+	return id
+}
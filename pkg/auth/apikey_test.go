@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	logrtesting "github.com/go-logr/logr/testing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sebastianrosch/couchconnections/internal/service"
+)
+
+// staticAPIKeys resolves a single API key and records the permissions of users.
+type staticAPIKeys struct {
+	key         string
+	identity    *APIKeyIdentity
+	permissions map[string][]string
+}
+
+func (s *staticAPIKeys) ResolveAPIKey(ctx context.Context, key string) (*APIKeyIdentity, error) {
+	if key != s.key {
+		return nil, NewInvalidAPIKeyError("the key is unknown or revoked")
+	}
+	return s.identity, nil
+}
+
+func (s *staticAPIKeys) RecordPermissions(ctx context.Context, userID string, permissions []string) error {
+	s.permissions[userID] = permissions
+	return nil
+}
+
+// noMethodInfo never skips authentication.
+type noMethodInfo struct{}
+
+func (noMethodInfo) GetMethodInfo(ctx context.Context) *service.MethodInfo {
+	return nil
+}
+
+var _ = Describe("API keys", func() {
+	withHeader := func(key, value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(key, value))
+	}
+
+	Describe("when the token is read from the request", func() {
+		tokenContext := &APIKeyTokenContext{}
+
+		It("should read bearer tokens", func() {
+			Expect(tokenContext.GetAuthTokenFromAuthorizationHeader(withHeader("authorization", "Bearer cck_key"))).To(Equal("cck_key"))
+		})
+
+		It("should read the API key header", func() {
+			Expect(tokenContext.GetAuthTokenFromAuthorizationHeader(withHeader("x-api-key", "cck_key"))).To(Equal("cck_key"))
+		})
+
+		It("should return an empty token without headers", func() {
+			Expect(tokenContext.GetAuthTokenFromAuthorizationHeader(context.Background())).To(BeEmpty())
+		})
+	})
+
+	Describe("when a request is authenticated with an API key", func() {
+		var authenticator *TokenAuthenticator
+
+		BeforeEach(func() {
+			apiKeys := &staticAPIKeys{key: "cck_valid", permissions: map[string][]string{}, identity: &APIKeyIdentity{
+				ID:          "key-1",
+				UserID:      "anna",
+				UserName:    "Anna",
+				Permissions: []string{"capability:couchconnections:write"},
+			}}
+			validator := &staticTokenValidator{err: errors.New("API keys must not be validated as access tokens")}
+			authenticator = NewAuthenticator(logrtesting.NullLogger{}, nil, validator, nil, apiKeys, noMethodInfo{}, &APIKeyTokenContext{})
+		})
+
+		It("should authenticate the user of the key with the permissions of the key", func() {
+			ctx, err := authenticator.Authenticate(withHeader("x-api-key", "cck_valid"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(GetUserInfoFromContext(ctx).Sub).To(Equal("anna"))
+			Expect(GetAuthorizationPermissionsFromContext(ctx)).To(ConsistOf("capability:couchconnections:write"))
+			Expect(GetAPIKeyIDFromContext(ctx)).To(Equal("key-1"))
+		})
+
+		It("should reject invalid keys", func() {
+			_, err := authenticator.Authenticate(withHeader("authorization", "Bearer cck_revoked"))
+
+			Expect(err).To(MatchError("invalid auth token"))
+		})
+	})
+
+	Describe("when a request is authenticated with an access token", func() {
+		It("should record the permissions of the user for their API keys", func() {
+			apiKeys := &staticAPIKeys{permissions: map[string][]string{}}
+			validator := &staticTokenValidator{claims: &AccessTokenClaims{Sub: "anna", Permissions: []string{"capability:couchconnections:read"}}}
+			userInfoCache, err := NewUserInfoCache(&countingUserInfoProvider{}, &UserInfoCacheConfig{TTL: time.Minute}, prometheus.NewRegistry())
+			Expect(err).ToNot(HaveOccurred())
+			authenticator := NewAuthenticator(logrtesting.NullLogger{}, nil, validator, userInfoCache, apiKeys, noMethodInfo{}, &APIKeyTokenContext{})
+
+			_, err = authenticator.Authenticate(withHeader("authorization", "Bearer anna"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiKeys.permissions).To(HaveKeyWithValue("anna", []string{"capability:couchconnections:read"}))
+		})
+	})
+})
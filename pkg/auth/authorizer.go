@@ -46,6 +46,11 @@ func WithAuthorizationPermissions(ctx context.Context, permissions []string) con
 	return context.WithValue(ctx, permissionsKey{}, permissions)
 }
 
+// GetAuthorizationPermissionsFromContext returns the authorization permissions added with WithAuthorizationPermissions
+func GetAuthorizationPermissionsFromContext(ctx context.Context) []string {
+	return defaultGetPermissionsFromContext(ctx)
+}
+
 // Authorizer is the struct used to perform authorization assertions
 // Use NewAuthorizer to build.
 type Authorizer struct {
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/mitchellh/mapstructure"
+)
+
+// claimsValidator validates the time, issuer, audience and scope claims of a token.
+// Empty issuers, audiences or required scopes are not validated.
+type claimsValidator struct {
+	issuers        []string
+	audiences      []string
+	requiredScopes []string
+	leeway         time.Duration
+}
+
+// validate validates the claims of a token at the given time.
+func (v *claimsValidator) validate(claims jwt.MapClaims, now time.Time) error {
+	exp, hasExp, err := timeClaim(claims, "exp")
+	if err != nil {
+		return NewMalformedTokenError(err)
+	}
+	nbf, hasNbf, err := timeClaim(claims, "nbf")
+	if err != nil {
+		return NewMalformedTokenError(err)
+	}
+	iat, hasIat, err := timeClaim(claims, "iat")
+	if err != nil {
+		return NewMalformedTokenError(err)
+	}
+
+	if hasExp && !now.Before(exp.Add(v.leeway)) {
+		return NewTokenExpiredError(exp)
+	}
+	if hasNbf && now.Add(v.leeway).Before(nbf) {
+		return NewTokenNotValidYetError(nbf)
+	}
+	if hasIat && now.Add(v.leeway).Before(iat) {
+		return NewTokenNotValidYetError(iat)
+	}
+
+	if len(v.issuers) > 0 {
+		issuer, _ := claims["iss"].(string)
+		if !contains(v.issuers, issuer) {
+			return NewInvalidIssuerError(issuer)
+		}
+	}
+
+	if len(v.audiences) > 0 {
+		audiences := stringsClaim(claims, "aud")
+		if !containsAny(v.audiences, audiences) {
+			return NewInvalidAudienceError(audiences)
+		}
+	}
+
+	if len(v.requiredScopes) > 0 {
+		scopes := strings.Fields(stringClaim(claims, "scope"))
+		scopes = append(scopes, stringsClaim(claims, "scp")...)
+
+		var missingScopes []string
+		for _, scope := range v.requiredScopes {
+			if !contains(scopes, scope) {
+				missingScopes = append(missingScopes, scope)
+			}
+		}
+		if len(missingScopes) > 0 {
+			return NewMissingScopesError(missingScopes)
+		}
+	}
+
+	return nil
+}
+
+// accessTokenClaimsFromMap decodes validated claims as [access token](#type-accesstokenclaims).
+func accessTokenClaimsFromMap(claims jwt.MapClaims) (*AccessTokenClaims, error) {
+	// A single audience may be a string.
+	if aud, ok := claims["aud"].(string); ok {
+		claims["aud"] = []string{aud}
+	}
+
+	var accessTokenClaims AccessTokenClaims
+	err := mapstructure.Decode(claims, &accessTokenClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accessTokenClaims, nil
+}
+
+// timeClaim returns the time of a NumericDate claim, if the token has the claim.
+// An error is returned if the claim is not a number.
+func timeClaim(claims jwt.MapClaims, name string) (time.Time, bool, error) {
+	var seconds float64
+	switch value := claims[name].(type) {
+	case float64:
+		seconds = value
+	case json.Number:
+		v, err := value.Float64()
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("Claim %q is not a NumericDate: %s", name, err)
+		}
+		seconds = v
+	default:
+		if _, ok := claims[name]; ok {
+			return time.Time{}, false, fmt.Errorf("Claim %q is not a NumericDate", name)
+		}
+		return time.Time{}, false, nil
+	}
+
+	return time.Unix(int64(seconds), 0), true, nil
+}
+
+// stringClaim returns the value of a string claim or an empty string.
+func stringClaim(claims jwt.MapClaims, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// stringsClaim returns the values of a claim that is either a string or an array of strings.
+func stringsClaim(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
+
+// containsAny returns true if any of the values is one of the expected values.
+func containsAny(expected []string, values []string) bool {
+	for _, value := range values {
+		if contains(expected, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/credentials"
+)
+
+var _ credentials.PerRPCCredentials = &TokenCredentials{}
+
+// TokenCredentials attaches the access token of a token source to every gRPC call
+// Use NewTokenCredentials to build and pass it to grpc.WithPerRPCCredentials.
+type TokenCredentials struct {
+	source        TokenSource
+	allowInsecure bool
+}
+
+// GetRequestMetadata returns the authorization header with the access token
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"authorization": "Bearer " + token.AccessToken}, nil
+}
+
+// RequireTransportSecurity returns true unless insecure connections are allowed
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return !c.allowInsecure
+}
+
+// NewTokenCredentials returns a new instance of [TokenCredentials](#type-tokencredentials)
+// allowInsecure allows sending the token over connections without TLS, e.g. within a cluster.
+func NewTokenCredentials(source TokenSource, allowInsecure bool) *TokenCredentials {
+	return &TokenCredentials{source: source, allowInsecure: allowInsecure}
+}
+
+// TokenTransport is an http.RoundTripper that attaches the access token of a token source to every request
+// Use NewTokenTransport to build.
+type TokenTransport struct {
+	source TokenSource
+	base   http.RoundTripper
+}
+
+// RoundTrip sends the request with the authorization header
+func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+	if err != nil {
+		// The transport must close the body, even if the request is not sent.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// The request must not be modified, so the header is set on a copy.
+	authorized := req.Clone(req.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	return t.base.RoundTrip(authorized)
+}
+
+// NewTokenTransport returns a new instance of [TokenTransport](#type-tokentransport)
+// If base is nil, http.DefaultTransport is used.
+func NewTokenTransport(source TokenSource, base http.RoundTripper) *TokenTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &TokenTransport{source: source, base: base}
+}
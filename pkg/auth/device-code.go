@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// defaultDevicePollInterval is the polling interval if the IDP doesn't return one (RFC 8628 section 3.2)
+	defaultDevicePollInterval = 5 * time.Second
+	// slowDownIncrement is added to the polling interval for every slow_down error (RFC 8628 section 3.5)
+	slowDownIncrement = 5 * time.Second
+)
+
+// DeviceAuthorizationResponse contains the codes returned by the device authorization endpoint (RFC 8628 section 3.2)
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type deviceAuthorizationEndpointResponse struct {
+	DeviceAuthorizationResponse
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// DeviceCodePrompt shows the user where to enter the user code
+type DeviceCodePrompt interface {
+	ShowDeviceCode(deviceCode *DeviceAuthorizationResponse) error
+}
+
+// WriterDeviceCodePrompt writes the verification URI and the user code to a writer, e.g. the terminal
+type WriterDeviceCodePrompt struct {
+	writer io.Writer
+}
+
+// ShowDeviceCode writes the instructions for the user
+func (p *WriterDeviceCodePrompt) ShowDeviceCode(deviceCode *DeviceAuthorizationResponse) error {
+	if deviceCode.VerificationURIComplete != "" {
+		_, err := fmt.Fprintf(p.writer, "To sign in, open %s and confirm the code %s\n",
+			deviceCode.VerificationURIComplete, deviceCode.UserCode)
+		return err
+	}
+
+	_, err := fmt.Fprintf(p.writer, "To sign in, open %s and enter the code %s\n",
+		deviceCode.VerificationURI, deviceCode.UserCode)
+	return err
+}
+
+// NewWriterDeviceCodePrompt returns a new instance of [WriterDeviceCodePrompt](#type-writerdevicecodeprompt)
+func NewWriterDeviceCodePrompt(writer io.Writer) *WriterDeviceCodePrompt {
+	return &WriterDeviceCodePrompt{writer: writer}
+}
+
+// DeviceCode signs in a user on a device without a browser (device flow).
+// It requests a device code, shows the user code with the prompt and polls the token endpoint
+// until the user has authorized the device, denied the request or the code has expired.
+func (t *TokenRetriever) DeviceCode(ctx context.Context, audience string, scope []string, prompt DeviceCodePrompt) (*TokenResponse, error) {
+	deviceCode, err := t.RequestDeviceCode(ctx, audience, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prompt.ShowDeviceCode(deviceCode); err != nil {
+		return nil, err
+	}
+
+	return t.PollDeviceToken(ctx, deviceCode)
+}
+
+// RequestDeviceCode requests a [device code and user code](#type-deviceauthorizationresponse) from the device authorization endpoint.
+// The audience and scope are optional.
+func (t *TokenRetriever) RequestDeviceCode(ctx context.Context, audience string, scope []string) (*DeviceAuthorizationResponse, error) {
+	formData := map[string][]string{
+		"client_id": {t.config.ClientID},
+	}
+	if audience != "" {
+		formData["audience"] = []string{audience}
+	}
+	if len(scope) > 0 {
+		formData["scope"] = []string{strings.Join(scope, " ")}
+	}
+
+	resp, err := t.utils.PostForm(ctx, t.config.DeviceAuthorizationURL, formData, t.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result deviceAuthorizationEndpointResponse
+	if err := t.utils.DecodeJSON(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("Error decoding device authorization response: %s", err)
+	}
+
+	if result.Error != "" {
+		return nil, NewTokenEndpointError(result.Error, result.ErrorDescription)
+	}
+	if result.DeviceCode == "" || result.UserCode == "" {
+		return nil, fmt.Errorf("The device authorization endpoint returned no device code")
+	}
+
+	return &result.DeviceAuthorizationResponse, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user has authorized the device and returns the [access token](#type-tokenresponse).
+// The endpoint is polled at the interval of the device code, which is increased by 5 seconds whenever the endpoint asks to slow down.
+// A TokenEndpointError with the code "expired_token" is returned if the device code expires,
+// and one with the code "access_denied" if the user denies the request.
+func (t *TokenRetriever) PollDeviceToken(ctx context.Context, deviceCode *DeviceAuthorizationResponse) (*TokenResponse, error) {
+	formData := map[string][]string{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceCode.DeviceCode},
+		"client_id":   {t.config.ClientID},
+	}
+
+	interval := time.Duration(deviceCode.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+	// The expiry is tracked with the waited time, so that the code is not polled long after it has expired.
+	remaining := time.Duration(deviceCode.ExpiresIn) * time.Second
+
+	for {
+		if deviceCode.ExpiresIn > 0 && remaining < interval {
+			return nil, NewTokenEndpointError("expired_token", "The device code expired before the device was authorized")
+		}
+		if err := t.wait(ctx, interval); err != nil {
+			return nil, err
+		}
+		remaining -= interval
+
+		token, err := t.tokenEndpointRequest(ctx, formData)
+		if err == nil {
+			return token, nil
+		}
+
+		terr, ok := err.(*TokenEndpointError)
+		if !ok {
+			return nil, err
+		}
+		switch terr.Code {
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownIncrement
+		default:
+			return nil, terr
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device authorization", func() {
+	ctx := context.Background()
+
+	var server *httptest.Server
+	var retriever *TokenRetriever
+	var tokenResponses []map[string]interface{}
+	var waits []time.Duration
+
+	BeforeEach(func() {
+		waits = nil
+		tokenResponses = nil
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.FormValue("client_id")).To(Equal("cli"))
+			Expect(r.FormValue("audience")).To(Equal("https://api.couchconnections"))
+			Expect(r.FormValue("scope")).To(Equal("openid offline_access"))
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"device_code":      "device-code",
+				"user_code":        "WDJB-MJHT",
+				"verification_uri": "https://example.com/device",
+				"expires_in":       60,
+			})
+		})
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.FormValue("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:device_code"))
+			Expect(r.FormValue("device_code")).To(Equal("device-code"))
+			Expect(r.FormValue("client_id")).To(Equal("cli"))
+			Expect(tokenResponses).ToNot(BeEmpty())
+
+			response := tokenResponses[0]
+			tokenResponses = tokenResponses[1:]
+			if _, ok := response["error"]; ok {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		})
+		server = httptest.NewServer(mux)
+
+		retriever = NewTokenRetriever(&TokenRetrieverConfig{
+			ClientID:               "cli",
+			TokenURL:               server.URL + "/token",
+			DeviceAuthorizationURL: server.URL + "/device",
+		}, server.Client())
+		retriever.wait = func(ctx context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("when the user authorizes the device", func() {
+		It("should show the user code and poll until the token is issued", func() {
+			tokenResponses = []map[string]interface{}{
+				{"error": "authorization_pending"},
+				{"error": "slow_down"},
+				{"error": "authorization_pending"},
+				{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600},
+			}
+			var output bytes.Buffer
+
+			token, err := retriever.DeviceCode(ctx, "https://api.couchconnections", []string{"openid", "offline_access"}, NewWriterDeviceCodePrompt(&output))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("access-token"))
+			Expect(output.String()).To(Equal("To sign in, open https://example.com/device and enter the code WDJB-MJHT\n"))
+			Expect(waits).To(Equal([]time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second, 10 * time.Second}))
+		})
+	})
+
+	Describe("when the device code is polled", func() {
+		device := func() *DeviceAuthorizationResponse {
+			return &DeviceAuthorizationResponse{DeviceCode: "device-code", UserCode: "WDJB-MJHT", ExpiresIn: 60, Interval: 10}
+		}
+
+		It("should use the interval of the device code", func() {
+			tokenResponses = []map[string]interface{}{
+				{"access_token": "access-token"},
+			}
+
+			_, err := retriever.PollDeviceToken(ctx, device())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(waits).To(Equal([]time.Duration{10 * time.Second}))
+		})
+
+		It("should stop when the token endpoint reports the code as expired", func() {
+			tokenResponses = []map[string]interface{}{
+				{"error": "expired_token", "error_description": "The device code has expired"},
+			}
+
+			_, err := retriever.PollDeviceToken(ctx, device())
+
+			Expect(err).To(Equal(NewTokenEndpointError("expired_token", "The device code has expired")))
+		})
+
+		It("should stop when the user denies the request", func() {
+			tokenResponses = []map[string]interface{}{
+				{"error": "access_denied"},
+			}
+
+			_, err := retriever.PollDeviceToken(ctx, device())
+
+			Expect(err).To(BeAssignableToTypeOf(&TokenEndpointError{}))
+			Expect(err.(*TokenEndpointError).Code).To(Equal("access_denied"))
+		})
+
+		It("should stop polling once the device code has expired", func() {
+			for i := 0; i < 10; i++ {
+				tokenResponses = append(tokenResponses, map[string]interface{}{"error": "authorization_pending"})
+			}
+
+			_, err := retriever.PollDeviceToken(ctx, device())
+
+			Expect(err).To(BeAssignableToTypeOf(&TokenEndpointError{}))
+			Expect(err.(*TokenEndpointError).Code).To(Equal("expired_token"))
+			Expect(waits).To(HaveLen(6))
+		})
+
+		It("should stop when the context is cancelled", func() {
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+			retriever.wait = wait
+
+			_, err := retriever.PollDeviceToken(cancelled, device())
+
+			Expect(err).To(Equal(context.Canceled))
+		})
+	})
+})
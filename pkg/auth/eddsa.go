@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"crypto/ed25519"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// SigningMethodEdDSA implements the EdDSA signing method of RFC 8037 with Ed25519 keys
+// Expects ed25519.PrivateKey for signing and ed25519.PublicKey for verification
+type SigningMethodEdDSA struct{}
+
+// EdDSA is the Ed25519 signing method, registered with jwt-go as "EdDSA"
+var EdDSA *SigningMethodEdDSA
+
+func init() {
+	EdDSA = &SigningMethodEdDSA{}
+	jwt.RegisterSigningMethod(EdDSA.Alg(), func() jwt.SigningMethod {
+		return EdDSA
+	})
+}
+
+// Alg returns the name of the signing method
+func (m *SigningMethodEdDSA) Alg() string {
+	return "EdDSA"
+}
+
+// Verify checks the signature of the signing string with an ed25519.PublicKey
+func (m *SigningMethodEdDSA) Verify(signingString, signature string, key interface{}) error {
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok || len(publicKey) != ed25519.PublicKeySize {
+		return jwt.ErrInvalidKeyType
+	}
+
+	sig, err := jwt.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(publicKey, []byte(signingString), sig) {
+		return jwt.ErrSignatureInvalid
+	}
+
+	return nil
+}
+
+// Sign signs the signing string with an ed25519.PrivateKey
+func (m *SigningMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok || len(privateKey) != ed25519.PrivateKeySize {
+		return "", jwt.ErrInvalidKeyType
+	}
+
+	return jwt.EncodeSegment(ed25519.Sign(privateKey, []byte(signingString))), nil
+}
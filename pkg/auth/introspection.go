@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+const defaultIntrospectionHTTPTimeout = 10 * time.Second
+
+// IntrospectionConfig contains the configurations for the token introspection
+type IntrospectionConfig struct {
+	// Endpoint is the RFC 7662 introspection endpoint of the authorization server
+	Endpoint string
+	// ClientID and ClientSecret authenticate the API at the introspection endpoint
+	ClientID     string
+	ClientSecret string
+	// Issuers are the accepted "iss" values. If empty, every issuer is accepted.
+	Issuers []string
+	// Audiences are the accepted "aud" values. A token must be intended for at least one of them. If empty, every audience is accepted.
+	Audiences []string
+	// RequiredScopes are the scopes that a token must grant
+	RequiredScopes []string
+	// Leeway is the clock skew allowed when the "exp", "nbf" and "iat" values are validated (Default: 1m)
+	Leeway time.Duration
+}
+
+// IntrospectionValidator validates access tokens with an OAuth 2.0 token introspection endpoint (RFC 7662)
+// It accepts opaque tokens as well as JWTs, but calls the endpoint for every token.
+// Use NewIntrospectionValidator to build.
+type IntrospectionValidator struct {
+	config     IntrospectionConfig
+	httpClient *http.Client
+	claims     claimsValidator
+	now        Clock
+}
+
+// ValidateAccessToken asks the introspection endpoint whether the token is active and returns its [claims](#type-accesstokenclaims)
+// The "client_id" of the response is returned as the authorized party.
+func (v *IntrospectionValidator) ValidateAccessToken(ctx context.Context, accessToken string) (*AccessTokenClaims, error) {
+	claims, err := v.introspect(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if active, _ := claims["active"].(bool); !active {
+		return nil, NewInactiveTokenError()
+	}
+	if err := v.claims.validate(claims, v.now()); err != nil {
+		return nil, err
+	}
+	if _, ok := claims["azp"]; !ok {
+		claims["azp"] = claims["client_id"]
+	}
+
+	return accessTokenClaimsFromMap(claims)
+}
+
+// introspect requests the introspection response of the token.
+func (v *IntrospectionValidator) introspect(ctx context.Context, accessToken string) (jwt.MapClaims, error) {
+	form := url.Values{}
+	form.Set("token", accessToken)
+	form.Set("token_type_hint", "access_token")
+
+	req, err := http.NewRequest(http.MethodPost, v.config.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// The client credentials are form-encoded before they are used for basic authentication (RFC 6749 section 2.3.1).
+	req.SetBasicAuth(url.QueryEscape(v.config.ClientID), url.QueryEscape(v.config.ClientSecret))
+
+	response, err := v.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Wrong response of the introspection endpoint. Status code: %d", response.StatusCode)
+	}
+
+	var claims jwt.MapClaims
+	if err := json.NewDecoder(response.Body).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("Error decoding introspection response: %s", err)
+	}
+
+	return claims, nil
+}
+
+// NewIntrospectionValidator returns a new instance of [IntrospectionValidator](#type-introspectionvalidator)
+// If httpClient is nil, a client with a timeout is used.
+func NewIntrospectionValidator(config *IntrospectionConfig, httpClient *http.Client) *IntrospectionValidator {
+	return NewIntrospectionValidatorWithBoundaries(config, httpClient, time.Now)
+}
+
+// NewIntrospectionValidatorWithBoundaries returns a new instance of [IntrospectionValidator](#type-introspectionvalidator) with the provided boundaries
+func NewIntrospectionValidatorWithBoundaries(config *IntrospectionConfig, httpClient *http.Client, now Clock) *IntrospectionValidator {
+	c := *config
+	if c.Leeway == 0 {
+		c.Leeway = defaultLeeway
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultIntrospectionHTTPTimeout}
+	}
+
+	return &IntrospectionValidator{
+		config:     c,
+		httpClient: httpClient,
+		claims: claimsValidator{
+			issuers:        c.Issuers,
+			audiences:      c.Audiences,
+			requiredScopes: c.RequiredScopes,
+			leeway:         c.Leeway,
+		},
+		now: now,
+	}
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Introspection validator", func() {
+	var server *httptest.Server
+	var response map[string]interface{}
+	var status int
+	var validator *IntrospectionValidator
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+		status = http.StatusOK
+		response = map[string]interface{}{
+			"active":                        true,
+			"sub":                           "anna",
+			"client_id":                     "frontend",
+			"iss":                           "https://login.couchconnections.org/",
+			"scope":                         "openid events",
+			"aud":                           "couchconnections",
+			"exp":                           now.Add(time.Hour).Unix(),
+			"http://couchconnections/roles": []string{"capability:couchconnections:admin"},
+		}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			clientID, clientSecret, ok := r.BasicAuth()
+			Expect(ok).To(BeTrue())
+			Expect(clientID).To(Equal("api"))
+			Expect(clientSecret).To(Equal("s%3Acret"))
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.FormValue("token")).To(Equal("opaque-token"))
+			Expect(r.FormValue("token_type_hint")).To(Equal("access_token"))
+
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(response)
+		}))
+		validator = NewIntrospectionValidatorWithBoundaries(&IntrospectionConfig{
+			Endpoint:       server.URL,
+			ClientID:       "api",
+			ClientSecret:   "s:cret",
+			Issuers:        []string{"https://login.couchconnections.org/"},
+			Audiences:      []string{"couchconnections"},
+			RequiredScopes: []string{"events"},
+		}, server.Client(), func() time.Time { return now })
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should return the claims of active tokens", func() {
+		claims, err := validator.ValidateAccessToken(context.Background(), "opaque-token")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(claims.Sub).To(Equal("anna"))
+		Expect(claims.Azp).To(Equal("frontend"))
+		Expect(claims.Aud).To(ConsistOf("couchconnections"))
+		Expect(claims.Exp).To(Equal(int(now.Add(time.Hour).Unix())))
+		Expect(claims.Permissions).To(ConsistOf("capability:couchconnections:admin"))
+	})
+
+	It("should reject inactive tokens", func() {
+		response = map[string]interface{}{"active": false}
+
+		_, err := validator.ValidateAccessToken(context.Background(), "opaque-token")
+
+		Expect(err).To(BeAssignableToTypeOf(&InactiveTokenError{}))
+	})
+
+	It("should validate the claims of active tokens", func() {
+		response["scope"] = "openid"
+
+		_, err := validator.ValidateAccessToken(context.Background(), "opaque-token")
+
+		Expect(err).To(BeAssignableToTypeOf(&MissingScopesError{}))
+	})
+
+	It("should reject tokens with a malformed expiry", func() {
+		response["exp"] = "tomorrow"
+
+		_, err := validator.ValidateAccessToken(context.Background(), "opaque-token")
+
+		Expect(err).To(BeAssignableToTypeOf(&MalformedTokenError{}))
+	})
+
+	It("should reject tokens of other issuers", func() {
+		response["iss"] = "https://login.example.com/"
+
+		_, err := validator.ValidateAccessToken(context.Background(), "opaque-token")
+
+		Expect(err).To(BeAssignableToTypeOf(&InvalidIssuerError{}))
+	})
+
+	It("should return an error if the endpoint rejects the request", func() {
+		status = http.StatusUnauthorized
+
+		_, err := validator.ValidateAccessToken(context.Background(), "opaque-token")
+
+		Expect(err).To(MatchError("Wrong response of the introspection endpoint. Status code: 401"))
+	})
+})
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// PublicKey returns the public key of the JWK
+// RSA keys are read from the certificate chain or from the modulus and exponent,
+// EC keys from the P-256, P-384 or P-521 coordinates and OKP keys from the Ed25519 public key.
+func (k *SigningKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		if len(k.X5c) > 0 {
+			return k.certificatePublicKey()
+		}
+		return k.rsaPublicKey()
+	case "EC":
+		return k.ecdsaPublicKey()
+	case "OKP":
+		return k.ed25519PublicKey()
+	}
+
+	return nil, fmt.Errorf("Unsupported key type %q of key %s", k.Kty, k.Kid)
+}
+
+// VerifiesAlgorithm returns true if a token signed with the algorithm can be verified with the key.
+func (k *SigningKey) VerifiesAlgorithm(alg string) bool {
+	if k.Use != "" && k.Use != "sig" {
+		return false
+	}
+	if k.Alg != "" && k.Alg != alg {
+		return false
+	}
+
+	switch k.Kty {
+	case "RSA":
+		return strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "PS")
+	case "EC":
+		return (alg == "ES256" && k.Crv == "P-256") ||
+			(alg == "ES384" && k.Crv == "P-384") ||
+			(alg == "ES512" && k.Crv == "P-521")
+	case "OKP":
+		return alg == "EdDSA" && k.Crv == "Ed25519"
+	}
+
+	return false
+}
+
+func (k *SigningKey) certificatePublicKey() (crypto.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(k.X5c[0])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid certificate of key %s: %s", k.Kid, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid certificate of key %s: %s", k.Kid, err)
+	}
+
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("Certificate of key %s has no RSA public key", k.Kid)
+	}
+
+	return publicKey, nil
+}
+
+func (k *SigningKey) rsaPublicKey() (crypto.PublicKey, error) {
+	n, err := decodeKeyParameter(k.Kid, "n", k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeKeyParameter(k.Kid, "e", k.E)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("Invalid exponent of key %s", k.Kid)
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func (k *SigningKey) ecdsaPublicKey() (crypto.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("Unsupported curve %q of key %s", k.Crv, k.Kid)
+	}
+
+	x, err := decodeKeyParameter(k.Kid, "x", k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeKeyParameter(k.Kid, "y", k.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !curve.IsOnCurve(publicKey.X, publicKey.Y) {
+		return nil, fmt.Errorf("Point of key %s is not on the curve %s", k.Kid, k.Crv)
+	}
+
+	return publicKey, nil
+}
+
+func (k *SigningKey) ed25519PublicKey() (crypto.PublicKey, error) {
+	if k.Crv != "Ed25519" {
+		return nil, fmt.Errorf("Unsupported curve %q of key %s", k.Crv, k.Kid)
+	}
+
+	x, err := decodeKeyParameter(k.Kid, "x", k.X)
+	if err != nil {
+		return nil, err
+	}
+	if len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("Invalid length of the public key %s", k.Kid)
+	}
+
+	return ed25519.PublicKey(x), nil
+}
+
+// decodeKeyParameter decodes a base64url encoded parameter of a JWK.
+func decodeKeyParameter(kid, name, value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("Parameter %s of key %s is missing", name, kid)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid parameter %s of key %s: %s", name, kid, err)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJWKSCacheTTL           = 10 * time.Minute
+	defaultJWKSCacheMinTTL        = time.Minute
+	defaultJWKSCacheMaxTTL        = 24 * time.Hour
+	defaultJWKSMinRefetchInterval = 30 * time.Second
+	defaultJWKSHTTPTimeout        = 10 * time.Second
+)
+
+// Clock returns the current time
+type Clock func() time.Time
+
+// JWKSCacheConfig contains the configurations for the JWKS cache
+type JWKSCacheConfig struct {
+	// URL is the JWKS endpoint of the identity provider
+	URL string
+	// DefaultTTL is how long the keys are cached if the response has no Cache-Control max-age (Default: 10m)
+	DefaultTTL time.Duration
+	// MinTTL and MaxTTL bound the max-age of the response (Default: 1m and 24h)
+	MinTTL time.Duration
+	MaxTTL time.Duration
+	// MinRefetchInterval is the minimum time between two fetches caused by tokens signed with an unknown key (Default: 30s)
+	MinRefetchInterval time.Duration
+}
+
+// JWKSCache caches the signing keys of a JWKS endpoint in memory
+// The keys are fetched again when they expire, in the background when the cache is started,
+// and when a token is signed with an unknown key, at most once per MinRefetchInterval.
+// Use NewJWKSCache to build.
+type JWKSCache struct {
+	config     JWKSCacheConfig
+	httpClient *http.Client
+	now        Clock
+
+	// fetchMutex serializes the requests to the JWKS endpoint.
+	fetchMutex sync.Mutex
+
+	mutex      sync.RWMutex
+	keys       []SigningKey
+	expiresAt  time.Time
+	fetchedAt  time.Time
+	generation int
+}
+
+// GetKey returns the signing key with the given key ID
+// Expired keys are fetched again. If that fails, the expired keys are used until the endpoint is available again.
+func (c *JWKSCache) GetKey(ctx context.Context, kid string) (*SigningKey, error) {
+	key, expired, generation := c.lookup(kid)
+	if key != nil && !expired {
+		return key, nil
+	}
+
+	if !c.canRefetch() {
+		// The keys may have been fetched since the lookup.
+		if key, _, _ = c.lookup(kid); key != nil {
+			return key, nil
+		}
+		return nil, fmt.Errorf("Unable to find a signing key that matches %s", kid)
+	}
+
+	if err := c.refresh(ctx, generation); err != nil {
+		if key != nil {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	if key, _, _ = c.lookup(kid); key == nil {
+		return nil, fmt.Errorf("Unable to find a signing key that matches %s", kid)
+	}
+
+	return key, nil
+}
+
+// Refresh fetches the keys from the JWKS endpoint
+func (c *JWKSCache) Refresh(ctx context.Context) error {
+	c.mutex.RLock()
+	generation := c.generation
+	c.mutex.RUnlock()
+
+	return c.refresh(ctx, generation)
+}
+
+// Start refreshes the keys in the background shortly before they expire, until the context is done
+func (c *JWKSCache) Start(ctx context.Context) {
+	go func() {
+		for {
+			timer := time.NewTimer(c.refreshDelay())
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				// Errors are ignored, because the keys are fetched again on demand.
+				_ = c.Refresh(ctx)
+			}
+		}
+	}()
+}
+
+// refreshDelay returns the time until the keys should be refreshed in the background.
+// The keys are fetched immediately the first time and then refreshed when 90% of their TTL has passed,
+// but at most once per MinRefetchInterval.
+func (c *JWKSCache) refreshDelay() time.Duration {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.fetchedAt.IsZero() {
+		return 0
+	}
+	delay := c.expiresAt.Sub(c.fetchedAt) * 9 / 10
+	delay -= c.now().Sub(c.fetchedAt)
+	if delay < c.config.MinRefetchInterval {
+		delay = c.config.MinRefetchInterval
+	}
+
+	return delay
+}
+
+// lookup returns the cached key with the given key ID, whether the cached keys are expired,
+// and the generation of the cached keys.
+func (c *JWKSCache) lookup(kid string) (*SigningKey, bool, int) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	expired := !c.now().Before(c.expiresAt)
+	for i := range c.keys {
+		if c.keys[i].Kid == kid {
+			key := c.keys[i]
+			return &key, expired, c.generation
+		}
+	}
+
+	return nil, expired, c.generation
+}
+
+// canRefetch returns true if the keys were fetched at least MinRefetchInterval ago.
+func (c *JWKSCache) canRefetch() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return !c.now().Before(c.fetchedAt.Add(c.config.MinRefetchInterval))
+}
+
+// refresh fetches the keys unless they were fetched by another request since the given generation.
+func (c *JWKSCache) refresh(ctx context.Context, generation int) error {
+	c.fetchMutex.Lock()
+	defer c.fetchMutex.Unlock()
+
+	c.mutex.RLock()
+	fetched := c.generation != generation
+	c.mutex.RUnlock()
+	if fetched {
+		return nil
+	}
+
+	keys, ttl, err := c.fetch(ctx)
+	if err != nil && ctx.Err() != nil {
+		// The request was cancelled by the caller and says nothing about the endpoint.
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Failed requests count as fetches, so that an unavailable endpoint is not called for every token.
+	c.fetchedAt = c.now()
+	if err != nil {
+		return err
+	}
+	c.keys = keys
+	c.expiresAt = c.fetchedAt.Add(ttl)
+	c.generation++
+
+	return nil
+}
+
+// fetch requests the keys from the JWKS endpoint and returns them with their TTL.
+func (c *JWKSCache) fetch(ctx context.Context) ([]SigningKey, time.Duration, error) {
+	req, err := http.NewRequest(http.MethodGet, c.config.URL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	response, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, 0, fmt.Errorf("Wrong response. Status code: %d", response.StatusCode)
+	}
+
+	var jwks JwksResponse
+	if err := json.NewDecoder(response.Body).Decode(&jwks); err != nil {
+		return nil, 0, fmt.Errorf("Error decoding JWKS: %s", err)
+	}
+
+	return jwks.Keys, c.ttl(response.Header.Get("Cache-Control")), nil
+}
+
+// ttl returns how long a response with the Cache-Control header can be cached.
+func (c *JWKSCache) ttl(cacheControl string) time.Duration {
+	ttl := c.config.DefaultTTL
+	for _, directive := range strings.Split(cacheControl, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-cache" || directive == "no-store":
+			return c.config.MinTTL
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err == nil && seconds >= 0 {
+				ttl = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	if ttl < c.config.MinTTL {
+		return c.config.MinTTL
+	}
+	if ttl > c.config.MaxTTL {
+		return c.config.MaxTTL
+	}
+	return ttl
+}
+
+// NewJWKSCache returns a new instance of [JWKSCache](#type-jwkscache)
+// If httpClient is nil, a client with a timeout is used.
+func NewJWKSCache(config *JWKSCacheConfig, httpClient *http.Client) *JWKSCache {
+	return NewJWKSCacheWithBoundaries(config, httpClient, time.Now)
+}
+
+// NewJWKSCacheWithBoundaries returns a new instance of [JWKSCache](#type-jwkscache) with the provided boundaries
+func NewJWKSCacheWithBoundaries(config *JWKSCacheConfig, httpClient *http.Client, now Clock) *JWKSCache {
+	c := *config
+	if c.DefaultTTL == 0 {
+		c.DefaultTTL = defaultJWKSCacheTTL
+	}
+	if c.MinTTL == 0 {
+		c.MinTTL = defaultJWKSCacheMinTTL
+	}
+	if c.MaxTTL == 0 {
+		c.MaxTTL = defaultJWKSCacheMaxTTL
+	}
+	if c.MinRefetchInterval == 0 {
+		c.MinRefetchInterval = defaultJWKSMinRefetchInterval
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultJWKSHTTPTimeout}
+	}
+
+	return &JWKSCache{
+		config:     c,
+		httpClient: httpClient,
+		now:        now,
+	}
+}
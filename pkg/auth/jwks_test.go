@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// jwksServer is a local JWKS endpoint that counts its requests.
+type jwksServer struct {
+	*httptest.Server
+
+	mutex        sync.Mutex
+	kids         []string
+	keys         []SigningKey
+	cacheControl string
+	status       int
+	requests     int
+}
+
+func newJWKSServer(kids ...string) *jwksServer {
+	s := &jwksServer{kids: kids, status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		s.requests++
+		if s.cacheControl != "" {
+			w.Header().Set("Cache-Control", s.cacheControl)
+		}
+		w.WriteHeader(s.status)
+		if s.status != http.StatusOK {
+			return
+		}
+		jwks := JwksResponse{Keys: append([]SigningKey{}, s.keys...)}
+		for _, kid := range s.kids {
+			jwks.Keys = append(jwks.Keys, SigningKey{Kid: kid, Kty: "RSA", Use: "sig"})
+		}
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	return s
+}
+
+func (s *jwksServer) set(f func(s *jwksServer)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	f(s)
+}
+
+func (s *jwksServer) requestCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests
+}
+
+var _ = Describe("JWKS cache", func() {
+	var server *jwksServer
+	var cache *JWKSCache
+	var now time.Time
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+		server = newJWKSServer("key-1")
+		cache = NewJWKSCacheWithBoundaries(&JWKSCacheConfig{
+			URL:                server.URL,
+			DefaultTTL:         10 * time.Minute,
+			MinRefetchInterval: 30 * time.Second,
+		}, server.Client(), func() time.Time { return now })
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("when a key is requested", func() {
+		It("should fetch the keys once", func() {
+			key, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.Kid).To(Equal("key-1"))
+
+			_, err = cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(1))
+		})
+
+		It("should fetch the keys again when the default TTL has passed", func() {
+			_, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(10 * time.Minute)
+			_, err = cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(server.requestCount()).To(Equal(2))
+		})
+	})
+
+	Describe("when the response has a Cache-Control header", func() {
+		It("should cache the keys for the max-age", func() {
+			server.set(func(s *jwksServer) { s.cacheControl = "public, max-age=3600" })
+			_, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(59 * time.Minute)
+			_, err = cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(1))
+
+			now = now.Add(time.Minute)
+			_, err = cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(2))
+		})
+
+		It("should cache the keys for at least the minimum TTL", func() {
+			server.set(func(s *jwksServer) { s.cacheControl = "no-cache" })
+			_, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(59 * time.Second)
+			_, err = cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(1))
+		})
+	})
+
+	Describe("when a token is signed with an unknown key", func() {
+		BeforeEach(func() {
+			_, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fetch the rotated keys", func() {
+			server.set(func(s *jwksServer) { s.kids = []string{"key-1", "key-2"} })
+			now = now.Add(30 * time.Second)
+
+			key, err := cache.GetKey(ctx, "key-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.Kid).To(Equal("key-2"))
+		})
+
+		It("should limit how often the keys are fetched", func() {
+			now = now.Add(30 * time.Second)
+			_, err := cache.GetKey(ctx, "unknown")
+			Expect(err).To(HaveOccurred())
+
+			for i := 0; i < 10; i++ {
+				now = now.Add(time.Second)
+				_, err = cache.GetKey(ctx, "unknown")
+				Expect(err).To(HaveOccurred())
+			}
+			Expect(server.requestCount()).To(Equal(2))
+
+			now = now.Add(30 * time.Second)
+			_, err = cache.GetKey(ctx, "unknown")
+			Expect(err).To(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(3))
+		})
+	})
+
+	Describe("when the endpoint is unavailable", func() {
+		It("should keep using the expired keys", func() {
+			_, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			server.set(func(s *jwksServer) { s.status = http.StatusServiceUnavailable })
+			now = now.Add(time.Hour)
+
+			key, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.Kid).To(Equal("key-1"))
+			Expect(server.requestCount()).To(Equal(2))
+		})
+
+		It("should return an error if no keys were fetched", func() {
+			server.set(func(s *jwksServer) { s.status = http.StatusServiceUnavailable })
+
+			_, err := cache.GetKey(ctx, "key-1")
+
+			Expect(err).To(MatchError("Wrong response. Status code: 503"))
+		})
+	})
+
+	Describe("when the caller cancels the request", func() {
+		It("should fetch the keys again for the next request", func() {
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+
+			_, err := cache.GetKey(cancelled, "key-1")
+			Expect(err).To(HaveOccurred())
+
+			key, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.Kid).To(Equal("key-1"))
+		})
+	})
+
+	Describe("when the cache is started", func() {
+		It("should fetch the keys in the background", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			cache.Start(ctx)
+
+			Eventually(server.requestCount).Should(Equal(1))
+			_, err := cache.GetKey(ctx, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(1))
+		})
+	})
+})
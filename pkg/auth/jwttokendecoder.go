@@ -1,11 +1,8 @@
 package auth
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
-	"regexp"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -20,6 +17,9 @@ type SigningKey struct {
 	X5c []string
 	N   string
 	E   string
+	Crv string
+	X   string
+	Y   string
 	Kid string
 	X5t string
 }
@@ -59,19 +59,43 @@ type IDTokenClaims struct {
 	UpdatedAt     string `mapstructure:"updated_at"`
 }
 
-type validSigningKey struct {
-	kid       string
-	publicKey string
+// DefaultAllowedAlgorithms are the signing algorithms accepted if none are configured
+var DefaultAllowedAlgorithms = []string{"RS256", "RS384", "RS512"}
+
+// defaultLeeway is the default clock skew allowed when the time claims of a token are validated
+const defaultLeeway = time.Minute
+
+// JWTTokenDecoderConfig contains the configurations for the token decoder
+type JWTTokenDecoderConfig struct {
+	// AllowedAlgorithms are the signing algorithms accepted in tokens, e.g. RS256, ES256 or EdDSA (Default: DefaultAllowedAlgorithms)
+	AllowedAlgorithms []string
+	// Issuers are the accepted "iss" claims. If empty, every issuer is accepted.
+	Issuers []string
+	// Audiences are the accepted "aud" claims. A token must be intended for at least one of them. If empty, every audience is accepted.
+	Audiences []string
+	// RequiredScopes are the scopes that a token must grant in its "scope" or "scp" claim
+	RequiredScopes []string
+	// Leeway is the clock skew allowed when the "exp", "nbf" and "iat" claims are validated (Default: 1m)
+	Leeway time.Duration
 }
 
 // JWTTokenDecoder provides functions to validate and decode JWT
 type JWTTokenDecoder struct {
-	jwksURL string
+	config JWTTokenDecoderConfig
+	jwks   *JWKSCache
+	claims claimsValidator
+	now    Clock
 }
 
 // DecodeAndValidate validates the jwt and returns the token parsed
 func (t *JWTTokenDecoder) DecodeAndValidate(tokenString string) (jwt.MapClaims, error) {
-	token, err := t.extractAndValidateToken(tokenString)
+	return t.decodeAndValidate(context.Background(), tokenString)
+}
+
+// decodeAndValidate validates the jwt and returns the token parsed.
+// The context is used if the signing key has to be fetched.
+func (t *JWTTokenDecoder) decodeAndValidate(ctx context.Context, tokenString string) (jwt.MapClaims, error) {
+	token, err := t.extractAndValidateToken(ctx, tokenString)
 	if err != nil {
 		return nil, err
 	}
@@ -91,13 +115,17 @@ func (t *JWTTokenDecoder) DecodeAndValidateAccessToken(accessTokenString string)
 		return nil, err
 	}
 
-	var accessTokenClaims AccessTokenClaims
-	err = mapstructure.Decode(claims, &accessTokenClaims)
+	return accessTokenClaimsFromMap(claims)
+}
+
+// ValidateAccessToken validates the jwt locally with the keys of the JWKS endpoint and returns its [claims](#type-accesstokenclaims)
+func (t *JWTTokenDecoder) ValidateAccessToken(ctx context.Context, accessToken string) (*AccessTokenClaims, error) {
+	claims, err := t.decodeAndValidate(ctx, accessToken)
 	if err != nil {
 		return nil, err
 	}
 
-	return &accessTokenClaims, nil
+	return accessTokenClaimsFromMap(claims)
 }
 
 // DecodeAndValidateIDToken validates the jwt and returns the token parsed as id token
@@ -115,103 +143,79 @@ func (t *JWTTokenDecoder) DecodeAndValidateIDToken(idTokenString string) (*IDTok
 
 	return &idTokenClaims, nil
 }
-func (t *JWTTokenDecoder) extractAndValidateToken(tokenString string) (*jwt.Token, error) {
-	// Add a 60 second leeway to prevent possible clock skew issues
-	jwt.TimeFunc = func() time.Time {
-		leeway := time.Minute
-		return time.Now().Add(leeway)
-	}
-
-	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
-		}
-
+func (t *JWTTokenDecoder) extractAndValidateToken(ctx context.Context, tokenString string) (*jwt.Token, error) {
+	// The claims are validated separately with the configured leeway to allow for clock skew,
+	// because the parser doesn't support a leeway.
+	parser := &jwt.Parser{ValidMethods: t.config.AllowedAlgorithms, SkipClaimsValidation: true}
+	token, err := parser.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		kid, ok := token.Header["kid"].(string)
 
 		if !ok {
 			return nil, fmt.Errorf("Kid not present in token headers")
 		}
 
-		pem, err := t.getSigningKey(kid)
-
-		if err != nil {
-			return nil, fmt.Errorf("Error getting signing key: %s", err)
-		}
-
-		return jwt.ParseRSAPublicKeyFromPEM([]byte(pem.publicKey))
+		return t.getPublicKey(ctx, kid, token.Method.Alg())
 	})
-}
-
-func (t *JWTTokenDecoder) getSigningKey(kid string) (*validSigningKey, error) {
-	keys, err := t.getSigningKeys()
-
 	if err != nil {
-		return nil, err
-	}
-
-	for _, k := range keys {
-		if k.kid == kid {
-			return &k, nil
+		if verr, ok := err.(*jwt.ValidationError); ok && verr.Errors&jwt.ValidationErrorMalformed != 0 {
+			return nil, NewMalformedTokenError(err)
 		}
+		return nil, NewInvalidSignatureError(err)
 	}
 
-	return nil, fmt.Errorf("Unable to find a signing key that matches %s", kid)
-}
-
-func (t *JWTTokenDecoder) getSigningKeys() ([]validSigningKey, error) {
-	keys, err := t.getJWKS()
-
-	if err != nil {
-		return nil, err
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("Error decoding claims")
 	}
-
-	var filteredSigningKeys []validSigningKey
-
-	for _, k := range keys {
-		if k.Use == "sig" &&
-			k.Kty == "RSA" &&
-			k.Kid != "" &&
-			((k.X5c != nil && len(k.X5c) > 0) || (k.N != "" && k.E != "")) {
-			validKey := validSigningKey{
-				kid:       k.Kid,
-				publicKey: t.certToPEM(k.X5c[0]),
-			}
-			filteredSigningKeys = append(filteredSigningKeys, validKey)
-		}
+	if err := t.claims.validate(claims, t.now()); err != nil {
+		return nil, err
 	}
 
-	return filteredSigningKeys, nil
+	return token, nil
 }
 
-func (t *JWTTokenDecoder) getJWKS() ([]SigningKey, error) {
-	response, err := http.Get(t.jwksURL)
-
+// getPublicKey returns the public key with the given key ID if it can verify the algorithm.
+func (t *JWTTokenDecoder) getPublicKey(ctx context.Context, kid, alg string) (interface{}, error) {
+	key, err := t.jwks.GetKey(ctx, kid)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Error getting signing key: %s", err)
 	}
 
-	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		return nil, fmt.Errorf("Wrong response. Status code: %d", response.StatusCode)
+	if !key.VerifiesAlgorithm(alg) {
+		return nil, fmt.Errorf("Signing key %s can't verify %s signatures", kid, alg)
 	}
 
-	var jwks JwksResponse
-
-	json.NewDecoder(response.Body).Decode(&jwks)
-
-	return jwks.Keys, nil
+	return key.PublicKey()
 }
 
-func (t *JWTTokenDecoder) certToPEM(cert string) string {
-	re := regexp.MustCompile(`.{1,64}`)
-	matches := re.FindAll([]byte(cert), -1)
-	c := bytes.Join(matches, []byte("\n"))
-
-	return fmt.Sprintf("-----BEGIN CERTIFICATE-----\n%s\n-----END CERTIFICATE-----\n", c)
+// NewJWTTokenDecoder returns a default token parser that caches the keys of the JWKS endpoint
+func NewJWTTokenDecoder(jwksURL string) *JWTTokenDecoder {
+	return NewJWTTokenDecoderWithJWKS(NewJWKSCache(&JWKSCacheConfig{URL: jwksURL}, nil), nil)
 }
 
-// NewJWTTokenDecoder returns a default token parser
-func NewJWTTokenDecoder(jwksURL string) *JWTTokenDecoder {
-	return &JWTTokenDecoder{jwksURL: jwksURL}
+// NewJWTTokenDecoderWithJWKS returns a token parser that gets the signing keys from the [JWKS cache](#type-jwkscache)
+// If config is nil, the default configuration is used.
+func NewJWTTokenDecoderWithJWKS(jwks *JWKSCache, config *JWTTokenDecoderConfig) *JWTTokenDecoder {
+	var c JWTTokenDecoderConfig
+	if config != nil {
+		c = *config
+	}
+	if len(c.AllowedAlgorithms) == 0 {
+		c.AllowedAlgorithms = DefaultAllowedAlgorithms
+	}
+	if c.Leeway == 0 {
+		c.Leeway = defaultLeeway
+	}
+
+	return &JWTTokenDecoder{
+		config: c,
+		jwks:   jwks,
+		claims: claimsValidator{
+			issuers:        c.Issuers,
+			audiences:      c.Audiences,
+			requiredScopes: c.RequiredScopes,
+			leeway:         c.Leeway,
+		},
+		now: time.Now,
+	}
 }
@@ -0,0 +1,297 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// signToken returns a token for the subject signed with the method and key.
+func signToken(method jwt.SigningMethod, kid string, key interface{}) string {
+	return signClaims(method, kid, key, jwt.MapClaims{
+		"sub": "anna",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+}
+
+// signClaims returns a token with the claims signed with the method and key.
+func signClaims(method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	Expect(err).ToNot(HaveOccurred())
+	return signed
+}
+
+// encodeKeyParameter encodes a JWK parameter, padded to the size in bytes.
+func encodeKeyParameter(value *big.Int, size int) string {
+	b := value.Bytes()
+	for len(b) < size {
+		b = append([]byte{0}, b...)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func rsaKey(kid string, key *rsa.PrivateKey) SigningKey {
+	return SigningKey{
+		Kid: kid,
+		Kty: "RSA",
+		Use: "sig",
+		N:   encodeKeyParameter(key.N, 0),
+		E:   encodeKeyParameter(big.NewInt(int64(key.E)), 0),
+	}
+}
+
+func certificateKey(kid string, key *rsa.PrivateKey) SigningKey {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "couchconnections"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return SigningKey{Kid: kid, Kty: "RSA", Use: "sig", X5c: []string{base64.StdEncoding.EncodeToString(der)}}
+}
+
+func ecKey(kid, crv string, key *ecdsa.PrivateKey) SigningKey {
+	size := (key.Curve.Params().BitSize + 7) / 8
+	return SigningKey{
+		Kid: kid,
+		Kty: "EC",
+		Crv: crv,
+		X:   encodeKeyParameter(key.X, size),
+		Y:   encodeKeyParameter(key.Y, size),
+	}
+}
+
+func ed25519Key(kid string, key ed25519.PublicKey) SigningKey {
+	return SigningKey{Kid: kid, Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(key)}
+}
+
+var _ = Describe("JWT token decoder", func() {
+	var server *jwksServer
+	var rsaPrivateKey *rsa.PrivateKey
+	var p256PrivateKey, p384PrivateKey *ecdsa.PrivateKey
+	var ed25519PrivateKey ed25519.PrivateKey
+
+	newDecoder := func(algorithms ...string) *JWTTokenDecoder {
+		return NewJWTTokenDecoderWithJWKS(NewJWKSCache(&JWKSCacheConfig{URL: server.URL}, server.Client()),
+			&JWTTokenDecoderConfig{AllowedAlgorithms: algorithms})
+	}
+
+	BeforeEach(func() {
+		var err error
+		rsaPrivateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		p256PrivateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		p384PrivateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		var ed25519PublicKey ed25519.PublicKey
+		ed25519PublicKey, ed25519PrivateKey, err = ed25519.GenerateKey(rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		server = newJWKSServer()
+		server.keys = []SigningKey{
+			rsaKey("rsa", rsaPrivateKey),
+			certificateKey("certificate", rsaPrivateKey),
+			ecKey("p256", "P-256", p256PrivateKey),
+			ecKey("p384", "P-384", p384PrivateKey),
+			ed25519Key("ed25519", ed25519PublicKey),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	DescribeTable("when a token is signed with an allowed algorithm",
+		func(method func() jwt.SigningMethod, kid string, key func() interface{}) {
+			decoder := newDecoder("RS256", "ES256", "ES384", "EdDSA")
+
+			claims, err := decoder.DecodeAndValidateAccessToken(signToken(method(), kid, key()))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims.Sub).To(Equal("anna"))
+		},
+		Entry("should accept RSA keys with modulus and exponent",
+			func() jwt.SigningMethod { return jwt.SigningMethodRS256 }, "rsa", func() interface{} { return rsaPrivateKey }),
+		Entry("should accept RSA keys with a certificate",
+			func() jwt.SigningMethod { return jwt.SigningMethodRS256 }, "certificate", func() interface{} { return rsaPrivateKey }),
+		Entry("should accept P-256 keys",
+			func() jwt.SigningMethod { return jwt.SigningMethodES256 }, "p256", func() interface{} { return p256PrivateKey }),
+		Entry("should accept P-384 keys",
+			func() jwt.SigningMethod { return jwt.SigningMethodES384 }, "p384", func() interface{} { return p384PrivateKey }),
+		Entry("should accept Ed25519 keys",
+			func() jwt.SigningMethod { return EdDSA }, "ed25519", func() interface{} { return ed25519PrivateKey }),
+	)
+
+	Describe("when a token is signed with an algorithm that is not allowed", func() {
+		It("should reject the token", func() {
+			decoder := newDecoder("RS256")
+
+			_, err := decoder.DecodeAndValidate(signToken(jwt.SigningMethodES256, "p256", p256PrivateKey))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject unsigned tokens", func() {
+			decoder := newDecoder("RS256")
+
+			_, err := decoder.DecodeAndValidate(signToken(jwt.SigningMethodNone, "rsa", jwt.UnsafeAllowNoneSignatureType))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("when the key doesn't match the algorithm", func() {
+		It("should reject the token", func() {
+			decoder := newDecoder("ES256", "ES384")
+
+			_, err := decoder.DecodeAndValidate(signToken(jwt.SigningMethodES384, "p256", p384PrivateKey))
+
+			Expect(err).To(MatchError(ContainSubstring("Signing key p256 can't verify ES384 signatures")))
+		})
+	})
+
+	Describe("when the token is signed with another key", func() {
+		It("should reject the token", func() {
+			other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			decoder := newDecoder("ES256")
+
+			_, err = decoder.DecodeAndValidate(signToken(jwt.SigningMethodES256, "p256", other))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("when the claims of a token are validated", func() {
+		var decoder *JWTTokenDecoder
+		var now time.Time
+
+		validate := func(claims jwt.MapClaims) error {
+			_, err := decoder.DecodeAndValidateAccessToken(signClaims(jwt.SigningMethodES256, "p256", p256PrivateKey, claims))
+			return err
+		}
+
+		BeforeEach(func() {
+			decoder = NewJWTTokenDecoderWithJWKS(NewJWKSCache(&JWKSCacheConfig{URL: server.URL}, server.Client()), &JWTTokenDecoderConfig{
+				AllowedAlgorithms: []string{"ES256"},
+				Issuers:           []string{"https://idp.example.com/"},
+				Audiences:         []string{"couchconnections"},
+				RequiredScopes:    []string{"events"},
+				Leeway:            time.Minute,
+			})
+			now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+			decoder.now = func() time.Time { return now }
+		})
+
+		claims := func(overrides jwt.MapClaims) jwt.MapClaims {
+			c := jwt.MapClaims{
+				"sub":   "anna",
+				"iss":   "https://idp.example.com/",
+				"aud":   []string{"other", "couchconnections"},
+				"scope": "openid events",
+				"iat":   time.Date(2020, 4, 1, 11, 0, 0, 0, time.UTC).Unix(),
+				"exp":   time.Date(2020, 4, 1, 13, 0, 0, 0, time.UTC).Unix(),
+			}
+			for name, value := range overrides {
+				if value == nil {
+					delete(c, name)
+				} else {
+					c[name] = value
+				}
+			}
+			return c
+		}
+
+		DescribeTable("should accept valid tokens",
+			func(overrides jwt.MapClaims) {
+				Expect(validate(claims(overrides))).To(Succeed())
+			},
+			Entry("with all claims", jwt.MapClaims{}),
+			Entry("expired within the leeway", jwt.MapClaims{"exp": time.Date(2020, 4, 1, 11, 59, 30, 0, time.UTC).Unix()}),
+			Entry("issued within the leeway", jwt.MapClaims{"iat": time.Date(2020, 4, 1, 12, 0, 30, 0, time.UTC).Unix()}),
+			Entry("with a single audience", jwt.MapClaims{"aud": "couchconnections"}),
+			Entry("with the scopes in scp", jwt.MapClaims{"scope": nil, "scp": []string{"events"}}),
+		)
+
+		DescribeTable("should reject invalid tokens",
+			func(overrides jwt.MapClaims, expected interface{}) {
+				Expect(validate(claims(overrides))).To(BeAssignableToTypeOf(expected))
+			},
+			Entry("expired tokens", jwt.MapClaims{"exp": time.Date(2020, 4, 1, 11, 59, 0, 0, time.UTC).Unix()}, &TokenExpiredError{}),
+			Entry("tokens not valid yet", jwt.MapClaims{"nbf": time.Date(2020, 4, 1, 12, 5, 0, 0, time.UTC).Unix()}, &TokenNotValidYetError{}),
+			Entry("tokens of another issuer", jwt.MapClaims{"iss": "https://other.example.com/"}, &InvalidIssuerError{}),
+			Entry("tokens without issuer", jwt.MapClaims{"iss": nil}, &InvalidIssuerError{}),
+			Entry("tokens for another audience", jwt.MapClaims{"aud": "other"}, &InvalidAudienceError{}),
+			Entry("tokens without the required scopes", jwt.MapClaims{"scope": "openid"}, &MissingScopesError{}),
+			Entry("tokens with a malformed expiry", jwt.MapClaims{"exp": "tomorrow"}, &MalformedTokenError{}),
+			Entry("tokens with a malformed start of validity", jwt.MapClaims{"nbf": true}, &MalformedTokenError{}),
+			Entry("tokens with a malformed issue time", jwt.MapClaims{"iat": "2020-04-01T11:00:00Z"}, &MalformedTokenError{}),
+		)
+
+		It("should reject tokens with an invalid signature", func() {
+			token := signClaims(jwt.SigningMethodES256, "p256", p256PrivateKey, claims(jwt.MapClaims{}))
+			token = token[:len(token)-4] + "AAAA"
+
+			_, err := decoder.DecodeAndValidate(token)
+
+			Expect(err).To(BeAssignableToTypeOf(&InvalidSignatureError{}))
+		})
+
+		It("should reject malformed tokens", func() {
+			_, err := decoder.DecodeAndValidate("not a token")
+
+			Expect(err).To(BeAssignableToTypeOf(&MalformedTokenError{}))
+		})
+	})
+
+	Describe("when the keys are fetched for a cancelled request", func() {
+		It("should not fetch the keys", func() {
+			decoder := newDecoder("ES256")
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := decoder.ValidateAccessToken(ctx, signToken(jwt.SigningMethodES256, "p256", p256PrivateKey))
+
+			Expect(err).To(HaveOccurred())
+			Expect(server.requestCount()).To(Equal(0))
+		})
+	})
+
+	Describe("when a JWK is invalid", func() {
+		It("should return an error for EC points that are not on the curve", func() {
+			key := ecKey("p256", "P-256", p256PrivateKey)
+			key.Y = key.X
+
+			_, err := key.PublicKey()
+
+			Expect(err).To(MatchError("Point of key p256 is not on the curve P-256"))
+		})
+
+		It("should return an error for RSA keys without exponent", func() {
+			key := rsaKey("rsa", rsaPrivateKey)
+			key.E = ""
+
+			_, err := key.PublicKey()
+
+			Expect(err).To(MatchError("Parameter e of key rsa is missing"))
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// OwnerResolver resolves the users who own a resource.
+type OwnerResolver interface {
+	// ResolveOwners returns the IDs of the users who own the resource with the given ID.
+	ResolveOwners(ctx context.Context, resourceID string) ([]string, error)
+}
+
+// OwnershipError is the error returned if the authenticated user neither owns a resource
+// nor has admin permissions for the capability.
+type OwnershipError struct {
+	AuthorizationError
+	resourceID string
+	userID     string
+}
+
+// Error returns the user and the resource that the user doesn't own
+func (oe OwnershipError) Error() string {
+	if oe.userID == "" {
+		return fmt.Sprintf("Anonymous users don't own %s", oe.resourceID)
+	}
+	return fmt.Sprintf("User %s doesn't own %s", oe.userID, oe.resourceID)
+}
+
+// GetResourceID returns the ID of the resource that the user doesn't own
+func (oe OwnershipError) GetResourceID() string {
+	return oe.resourceID
+}
+
+// NewOwnershipError returns a new instance of OwnershipError
+// The missing permissions are the permissions that would grant access without owning the resource.
+func NewOwnershipError(resourceID, userID string, missingPermissions []string) *OwnershipError {
+	return &OwnershipError{
+		AuthorizationError: AuthorizationError{missingPermissions: missingPermissions},
+		resourceID:         resourceID,
+		userID:             userID,
+	}
+}
+
+// AssertOwnerOrCapabilityAdmin asserts if the authenticated user of the context is one of the owners of a resource.
+// If the context has capability:admin permissions this will work too.
+func (a *Authorizer) AssertOwnerOrCapabilityAdmin(ctx context.Context, resourceID string, owners []string) error {
+	// If context has capability admin permission we don't check anything else
+	err := a.AssertCapabilityAdmin(ctx)
+	if err == nil {
+		return nil
+	}
+
+	var userID string
+	if user := GetUserInfoFromContext(ctx); user != nil {
+		userID = user.Sub
+	}
+	if userID != "" && contains(owners, userID) {
+		return nil
+	}
+
+	return NewOwnershipError(resourceID, userID, err.(*AuthorizationError).GetMissingPermissions())
+}
+
+// OwnershipAuthorizer is the struct used to perform ownership assertions on resources
+// whose owners are resolved on demand.
+// Use NewOwnershipAuthorizer to build.
+type OwnershipAuthorizer struct {
+	authorizer *Authorizer
+	resolver   OwnerResolver
+}
+
+// AssertResourceOwnerOrCapabilityAdmin asserts if the authenticated user of the context owns the resource with the given ID.
+// If the context has capability:admin permissions this will work too, without resolving the owners.
+// Errors of the resolver, e.g. because the resource doesn't exist, are returned unchanged.
+func (o *OwnershipAuthorizer) AssertResourceOwnerOrCapabilityAdmin(ctx context.Context, resourceID string) error {
+	if err := o.authorizer.AssertCapabilityAdmin(ctx); err == nil {
+		return nil
+	}
+
+	owners, err := o.resolver.ResolveOwners(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	return o.authorizer.AssertOwnerOrCapabilityAdmin(ctx, resourceID, owners)
+}
+
+// NewOwnershipAuthorizer returns an instance of OwnershipAuthorizer that resolves the owners of resources with the resolver
+func NewOwnershipAuthorizer(authorizer *Authorizer, resolver OwnerResolver) *OwnershipAuthorizer {
+	return &OwnershipAuthorizer{
+		authorizer: authorizer,
+		resolver:   resolver,
+	}
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// staticOwners resolves the owners of resources from a map.
+type staticOwners map[string][]string
+
+func (o staticOwners) ResolveOwners(ctx context.Context, resourceID string) ([]string, error) {
+	owners, ok := o[resourceID]
+	if !ok {
+		return nil, errors.New("resource not found")
+	}
+	return owners, nil
+}
+
+var _ = Describe("Ownership", func() {
+	var ownership *OwnershipAuthorizer
+
+	withUser := func(sub string, permissions ...string) context.Context {
+		ctx := context.Background()
+		if sub != "" {
+			ctx = WithUserInfo(ctx, &UserInfoResponse{Sub: sub})
+		}
+		return WithAuthorizationPermissions(ctx, permissions)
+	}
+
+	BeforeEach(func() {
+		authorizer, err := NewAuthorizer("couchconnections", nil)
+		Expect(err).ToNot(HaveOccurred())
+		ownership = NewOwnershipAuthorizer(authorizer, staticOwners{
+			"event-1": {"anna", "ben"},
+			"event-2": {},
+		})
+	})
+
+	DescribeTable("when the ownership of a resource is asserted",
+		func(ctx context.Context, resourceID string, allowed bool) {
+			err := ownership.AssertResourceOwnerOrCapabilityAdmin(ctx, resourceID)
+
+			if allowed {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			oerr, ok := err.(*OwnershipError)
+			Expect(ok).To(BeTrue())
+			Expect(oerr.GetResourceID()).To(Equal(resourceID))
+			Expect(oerr.GetMissingPermissions()).To(Equal([]string{"capability:couchconnections:admin"}))
+		},
+		Entry("allows an owner", withUser("anna"), "event-1", true),
+		Entry("allows another owner", withUser("ben"), "event-1", true),
+		Entry("allows an admin", withUser("carla", "capability:couchconnections:admin"), "event-1", true),
+		Entry("allows an admin without a user", withUser("", "capability:couchconnections:admin"), "event-2", true),
+		Entry("denies another user", withUser("carla"), "event-1", false),
+		Entry("denies a writer of the capability", withUser("carla", "capability:couchconnections:write"), "event-1", false),
+		Entry("denies an admin of another capability", withUser("carla", "capability:other:admin"), "event-1", false),
+		Entry("denies anonymous users", withUser(""), "event-1", false),
+		Entry("denies everyone but admins on resources without owners", withUser("anna"), "event-2", false),
+	)
+
+	Describe("when the owners can't be resolved", func() {
+		It("should return the error of the resolver", func() {
+			err := ownership.AssertResourceOwnerOrCapabilityAdmin(withUser("anna"), "unknown")
+
+			Expect(err).To(MatchError("resource not found"))
+		})
+	})
+})
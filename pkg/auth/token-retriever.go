@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/sebastianrosch/couchconnections/pkg/auth/internal"
 )
@@ -20,6 +21,8 @@ type TokenRetrieverConfig struct {
 	ClientID     string
 	ClientSecret string
 	TokenURL     string
+	// DeviceAuthorizationURL is the device authorization endpoint of the IDP, required for the device flow
+	DeviceAuthorizationURL string
 }
 
 type TokenResponse struct {
@@ -30,11 +33,29 @@ type TokenResponse struct {
 	ExpiresIn    int    `json:"expires_in"`
 }
 
+// TokenEndpointError is returned if the token endpoint responds with an error (RFC 6749 section 5.2)
+type TokenEndpointError struct {
+	Code        string
+	Description string
+}
+
+// Error returns the error code and description
+func (e TokenEndpointError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+// NewTokenEndpointError returns a new instance of TokenEndpointError
+func NewTokenEndpointError(code, description string) *TokenEndpointError {
+	return &TokenEndpointError{Code: code, Description: description}
+}
+
 // TokenRetriever exposes different functions that can be used for retrieving tokens from the IDP
 type TokenRetriever struct {
 	config     *TokenRetrieverConfig
 	utils      Utils
 	httpClient *http.Client
+	// wait waits for the duration or until the context is done.
+	wait func(ctx context.Context, d time.Duration) error
 }
 
 // AccessCode Returns an [access token](#type-tokenresponse) for a given access code and code verifier (PKCE flow).
@@ -76,18 +97,50 @@ func (t *TokenRetriever) TokenExchange(ctx context.Context, accessToken string,
 	return t.tokenEndpointRequest(ctx, formData)
 }
 
+// ClientCredentials requests an [access token](#type-tokenresponse) for the client itself with the client ID and secret (client credentials flow).
+// The audience and scope are optional.
+func (t *TokenRetriever) ClientCredentials(ctx context.Context, audience string, scope []string) (*TokenResponse, error) {
+	formData := map[string][]string{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {t.config.ClientID},
+		"client_secret": {t.config.ClientSecret},
+	}
+	if audience != "" {
+		formData["audience"] = []string{audience}
+	}
+	if len(scope) > 0 {
+		formData["scope"] = []string{strings.Join(scope, " ")}
+	}
+
+	token, err := t.tokenEndpointRequest(ctx, formData)
+	if err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("The token endpoint returned no access token")
+	}
+
+	return token, nil
+}
+
 func (t *TokenRetriever) tokenEndpointRequest(ctx context.Context, formData map[string][]string) (*TokenResponse, error) {
 	resp, err := t.utils.PostForm(ctx, t.config.TokenURL, formData, t.httpClient)
 	if err != nil {
-		fmt.Println(err)
 		return nil, err
 	}
+	defer resp.Body.Close()
 
+	// Errors of the token endpoint are returned with a 4xx status code and described in the body.
 	var result tokenEndpointResponse
-	t.utils.DecodeJSON(resp.Body, &result)
-
-	if result.Error != "" {
-		return nil, fmt.Errorf("%s: %s", result.Error, result.ErrorDescription)
+	decodeErr := t.utils.DecodeJSON(resp.Body, &result)
+	if decodeErr == nil && result.Error != "" {
+		return nil, NewTokenEndpointError(result.Error, result.ErrorDescription)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("Wrong response of the token endpoint. Status code: %d", resp.StatusCode)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("Error decoding token response: %s", decodeErr)
 	}
 
 	return &TokenResponse{
@@ -108,5 +161,18 @@ func NewTokenRetriever(config *TokenRetrieverConfig, httpClient *http.Client) *T
 
 // NewTokenRetrieverWithBoundaries rreturns a new instance of [TokenRetriever](#type-tokenretriever) with the provided boundaries
 func NewTokenRetrieverWithBoundaries(config *TokenRetrieverConfig, utils Utils, httpClient *http.Client) *TokenRetriever {
-	return &TokenRetriever{config: config, utils: utils, httpClient: httpClient}
+	return &TokenRetriever{config: config, utils: utils, httpClient: httpClient, wait: wait}
+}
+
+// wait waits for the duration or until the context is done.
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
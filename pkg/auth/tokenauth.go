@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 
@@ -22,16 +23,33 @@ type TokenContext interface {
 
 // TokenAuthenticator implements token authentication
 type TokenAuthenticator struct {
-	logger            logr.Logger
-	whitelist         []string
-	tokenDecoder      *JWTTokenDecoder
-	userInfoRetriever *UserInfoRetriever
-	metadata          MetadataRetriever
-	tokenContext      TokenContext
+	logger        logr.Logger
+	whitelist     []string
+	validator     TokenValidator
+	userInfoCache *UserInfoCache
+	apiKeys       APIKeyResolver
+	metadata      MetadataRetriever
+	tokenContext  TokenContext
 }
 
 type userInfoKey struct{}
 
+// WithUserInfo adds the [user information](#type-userinforesponse) of the authenticated user to the context
+func WithUserInfo(ctx context.Context, userInfo *UserInfoResponse) context.Context {
+	return context.WithValue(ctx, userInfoKey{}, userInfo)
+}
+
+// GetUserInfoFromContext returns the [user information](#type-userinforesponse) of the authenticated user
+// or nil if the request was not authenticated
+func GetUserInfoFromContext(ctx context.Context) *UserInfoResponse {
+	userInfo, ok := ctx.Value(userInfoKey{}).(*UserInfoResponse)
+	if !ok {
+		return nil
+	}
+
+	return userInfo
+}
+
 // Authenticate authenticates a request by validating the "authorization" header from the request metadata
 func (t *TokenAuthenticator) Authenticate(ctx context.Context) (context.Context, error) {
 	if t.skipAuthentication(ctx) {
@@ -39,8 +57,21 @@ func (t *TokenAuthenticator) Authenticate(ctx context.Context) (context.Context,
 	}
 
 	tokenString := t.tokenContext.GetAuthTokenFromAuthorizationHeader(ctx)
+	if t.apiKeys != nil && IsAPIKey(tokenString) {
+		return t.authenticateAPIKey(ctx, tokenString)
+	}
 
-	userInfo, err := t.userInfoRetriever.GetUserInfo(ctx, tokenString)
+	// The token is validated first, so that the identity provider is only asked for the user info of valid tokens.
+	accessTokenClaims, err := t.validator.ValidateAccessToken(ctx, tokenString)
+	if err != nil {
+		return nil, t.tokenValidationError(err)
+	}
+
+	var expiresAt time.Time
+	if accessTokenClaims.Exp > 0 {
+		expiresAt = time.Unix(int64(accessTokenClaims.Exp), 0)
+	}
+	userInfo, err := t.userInfoCache.GetUserInfo(ctx, tokenString, expiresAt)
 	if err != nil {
 		if uerr, ok := err.(UserInfoAuthorizationError); ok {
 			// We're logging this as info because it is most likely a problem with the token.
@@ -50,17 +81,68 @@ func (t *TokenAuthenticator) Authenticate(ctx context.Context) (context.Context,
 		return nil, err
 	}
 
-	accessTokenClaims, err := t.tokenDecoder.DecodeAndValidateAccessToken(tokenString)
+	ctx = WithUserInfo(ctx, userInfo)
+	ctx = WithAuthorizationPermissions(ctx, accessTokenClaims.Permissions)
+
+	if t.apiKeys != nil {
+		// The API keys of the user lose the permissions that were revoked from the user.
+		// The request is authenticated even if the permissions can't be recorded.
+		if err := t.apiKeys.RecordPermissions(ctx, userInfo.Sub, accessTokenClaims.Permissions); err != nil {
+			t.logger.Error(err, "failed to record the permissions of the user", "sub", userInfo.Sub)
+		}
+	}
+
+	return ctx, nil
+}
+
+// authenticateAPIKey authenticates a request as the user of the API key with the permissions of the key.
+func (t *TokenAuthenticator) authenticateAPIKey(ctx context.Context, key string) (context.Context, error) {
+	identity, err := t.apiKeys.ResolveAPIKey(ctx, key)
 	if err != nil {
-		return nil, err
+		return nil, t.tokenValidationError(err)
 	}
 
-	ctx = context.WithValue(ctx, userInfoKey{}, userInfo)
-	ctx = WithAuthorizationPermissions(ctx, accessTokenClaims.Permissions)
+	ctx = WithUserInfo(ctx, &UserInfoResponse{Sub: identity.UserID, Name: identity.UserName})
+	ctx = WithAuthorizationPermissions(ctx, identity.Permissions)
+	ctx = WithAPIKeyID(ctx, identity.ID)
 
 	return ctx, nil
 }
 
+// tokenValidationError logs why a token is invalid and returns the error for the caller.
+// Missing scopes are returned unchanged, so that they can be reported as missing permissions.
+func (t *TokenAuthenticator) tokenValidationError(err error) error {
+	// We're logging these as info because they are most likely a problem with the token.
+	switch verr := err.(type) {
+	case *TokenExpiredError:
+		t.logger.Info("authentication failed because the token is expired", "expired_at", verr.GetExpiredAt())
+		return errors.New("auth token is expired")
+	case *TokenNotValidYetError:
+		t.logger.Info("authentication failed because the token is not valid yet", "error", verr)
+		return errors.New("auth token is not valid yet")
+	case *InvalidIssuerError:
+		t.logger.Info("authentication failed because of the token issuer", "issuer", verr.GetIssuer())
+		return errors.New("invalid auth token")
+	case *InvalidAudienceError:
+		t.logger.Info("authentication failed because of the token audience", "audiences", verr.GetAudiences())
+		return errors.New("invalid auth token")
+	case *InactiveTokenError:
+		t.logger.Info("authentication failed because the token is not active")
+		return errors.New("invalid auth token")
+	case *InvalidAPIKeyError:
+		t.logger.Info("authentication failed because of the API key", "error", verr)
+		return errors.New("invalid auth token")
+	case *InvalidSignatureError, *MalformedTokenError:
+		t.logger.Info("authentication failed because the token can't be verified", "error", verr)
+		return errors.New("invalid auth token")
+	case *MissingScopesError:
+		t.logger.Info("authentication failed because of missing scopes", "missing_scopes", verr.GetMissingScopes())
+		return verr
+	}
+
+	return err
+}
+
 // skipAuthentication returns true if the current method is whitlisted from authentication
 func (t *TokenAuthenticator) skipAuthentication(ctx context.Context) bool {
 	methodInfo := t.metadata.GetMethodInfo(ctx)
@@ -77,19 +159,22 @@ func (t *TokenAuthenticator) skipAuthentication(ctx context.Context) bool {
 }
 
 // NewAuthenticator returns a new Authenticator
+// If apiKeys is nil, API keys are validated like access tokens and rejected.
 func NewAuthenticator(
 	logger logr.Logger,
 	whitelist []string,
-	tokenDecoder *JWTTokenDecoder,
-	userInfoRetriever *UserInfoRetriever,
+	validator TokenValidator,
+	userInfoCache *UserInfoCache,
+	apiKeys APIKeyResolver,
 	metadata MetadataRetriever,
 	tokenContext TokenContext) *TokenAuthenticator {
 	return &TokenAuthenticator{
-		logger:            logger,
-		whitelist:         whitelist,
-		tokenDecoder:      tokenDecoder,
-		userInfoRetriever: userInfoRetriever,
-		metadata:          metadata,
-		tokenContext:      tokenContext,
+		logger:        logger,
+		whitelist:     whitelist,
+		validator:     validator,
+		userInfoCache: userInfoCache,
+		apiKeys:       apiKeys,
+		metadata:      metadata,
+		tokenContext:  tokenContext,
 	}
 }
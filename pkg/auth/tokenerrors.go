@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MalformedTokenError is returned if a token can't be parsed
+type MalformedTokenError struct {
+	cause error
+}
+
+// Error returns the reason why the token can't be parsed
+func (e MalformedTokenError) Error() string {
+	return fmt.Sprintf("Token is malformed: %s", e.cause)
+}
+
+// NewMalformedTokenError returns a new instance of MalformedTokenError
+func NewMalformedTokenError(cause error) *MalformedTokenError {
+	return &MalformedTokenError{cause: cause}
+}
+
+// InvalidSignatureError is returned if the signature of a token can't be verified,
+// e.g. because the algorithm is not allowed or the signing key is unknown
+type InvalidSignatureError struct {
+	cause error
+}
+
+// Error returns the reason why the signature can't be verified
+func (e InvalidSignatureError) Error() string {
+	return fmt.Sprintf("Token signature is invalid: %s", e.cause)
+}
+
+// NewInvalidSignatureError returns a new instance of InvalidSignatureError
+func NewInvalidSignatureError(cause error) *InvalidSignatureError {
+	return &InvalidSignatureError{cause: cause}
+}
+
+// TokenExpiredError is returned if a token expired longer ago than the leeway allows
+type TokenExpiredError struct {
+	expiredAt time.Time
+}
+
+// Error returns when the token expired
+func (e TokenExpiredError) Error() string {
+	return fmt.Sprintf("Token expired at %s", e.expiredAt.UTC().Format(time.RFC3339))
+}
+
+// GetExpiredAt returns when the token expired
+func (e TokenExpiredError) GetExpiredAt() time.Time {
+	return e.expiredAt
+}
+
+// NewTokenExpiredError returns a new instance of TokenExpiredError
+func NewTokenExpiredError(expiredAt time.Time) *TokenExpiredError {
+	return &TokenExpiredError{expiredAt: expiredAt}
+}
+
+// TokenNotValidYetError is returned if a token is used before it was issued or before its "nbf" claim
+type TokenNotValidYetError struct {
+	validFrom time.Time
+}
+
+// Error returns when the token becomes valid
+func (e TokenNotValidYetError) Error() string {
+	return fmt.Sprintf("Token is not valid before %s", e.validFrom.UTC().Format(time.RFC3339))
+}
+
+// NewTokenNotValidYetError returns a new instance of TokenNotValidYetError
+func NewTokenNotValidYetError(validFrom time.Time) *TokenNotValidYetError {
+	return &TokenNotValidYetError{validFrom: validFrom}
+}
+
+// InvalidIssuerError is returned if a token was issued by an issuer that is not expected
+type InvalidIssuerError struct {
+	issuer string
+}
+
+// Error returns the issuer of the token
+func (e InvalidIssuerError) Error() string {
+	return fmt.Sprintf("Token issuer %q is not expected", e.issuer)
+}
+
+// GetIssuer returns the issuer of the token
+func (e InvalidIssuerError) GetIssuer() string {
+	return e.issuer
+}
+
+// NewInvalidIssuerError returns a new instance of InvalidIssuerError
+func NewInvalidIssuerError(issuer string) *InvalidIssuerError {
+	return &InvalidIssuerError{issuer: issuer}
+}
+
+// InvalidAudienceError is returned if a token is not intended for any of the expected audiences
+type InvalidAudienceError struct {
+	audiences []string
+}
+
+// Error returns the audiences of the token
+func (e InvalidAudienceError) Error() string {
+	return fmt.Sprintf("Token audiences [%s] are not expected", strings.Join(e.audiences, " "))
+}
+
+// GetAudiences returns the audiences of the token
+func (e InvalidAudienceError) GetAudiences() []string {
+	return e.audiences
+}
+
+// NewInvalidAudienceError returns a new instance of InvalidAudienceError
+func NewInvalidAudienceError(audiences []string) *InvalidAudienceError {
+	return &InvalidAudienceError{audiences: audiences}
+}
+
+// MissingScopesError is returned if a token doesn't grant all required scopes
+type MissingScopesError struct {
+	missingScopes []string
+}
+
+// Error returns the missing scopes
+func (e MissingScopesError) Error() string {
+	return fmt.Sprintf("Missing scopes %s", strings.Join(e.missingScopes, " "))
+}
+
+// GetMissingScopes returns the scopes that the token doesn't grant
+func (e MissingScopesError) GetMissingScopes() []string {
+	return e.missingScopes
+}
+
+// NewMissingScopesError returns a new instance of MissingScopesError
+func NewMissingScopesError(missingScopes []string) *MissingScopesError {
+	return &MissingScopesError{missingScopes: missingScopes}
+}
+
+// InactiveTokenError is returned if the introspection endpoint reports that a token is not active,
+// e.g. because it is unknown, revoked or expired
+type InactiveTokenError struct{}
+
+// Error returns that the token is not active
+func (e InactiveTokenError) Error() string {
+	return "Token is not active"
+}
+
+// NewInactiveTokenError returns a new instance of InactiveTokenError
+func NewInactiveTokenError() *InactiveTokenError {
+	return &InactiveTokenError{}
+}
+
+// InvalidAPIKeyError is returned if an API key is unknown, revoked or expired
+type InvalidAPIKeyError struct {
+	reason string
+}
+
+// Error returns why the API key is invalid
+func (e InvalidAPIKeyError) Error() string {
+	return fmt.Sprintf("API key is invalid: %s", e.reason)
+}
+
+// NewInvalidAPIKeyError returns a new instance of InvalidAPIKeyError
+func NewInvalidAPIKeyError(reason string) *InvalidAPIKeyError {
+	return &InvalidAPIKeyError{reason: reason}
+}
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// defaultTokenExpiryDelta is how long before its expiry a cached access token is renewed
+	defaultTokenExpiryDelta = time.Minute
+	// defaultTokenLifetime is how long an access token is cached if its expiry is unknown
+	defaultTokenLifetime = 5 * time.Minute
+
+	// tokenRequestTimeout limits the request for a new access token that is shared by concurrent calls.
+	tokenRequestTimeout = 30 * time.Second
+)
+
+// TokenSource returns access tokens
+type TokenSource interface {
+	// Token returns an [access token](#type-tokenresponse) that is valid for at least a short while
+	Token(ctx context.Context) (*TokenResponse, error)
+}
+
+// TokenSourceFunc is a function that requests a new access token
+type TokenSourceFunc func(ctx context.Context) (*TokenResponse, error)
+
+// Token requests a new access token
+func (f TokenSourceFunc) Token(ctx context.Context) (*TokenResponse, error) {
+	return f(ctx)
+}
+
+// CachingTokenSource caches the access token of another source and requests a new one shortly before it expires
+// Concurrent calls share the same request. Tokens without "expires_in" are cached until the "exp" claim of the
+// access token or, if it isn't a JWT with an expiry, for a short default lifetime.
+// Use NewCachingTokenSource to build.
+type CachingTokenSource struct {
+	source      TokenSource
+	expiryDelta time.Duration
+	now         Clock
+
+	mutex   sync.Mutex
+	token   *TokenResponse
+	renewAt time.Time
+
+	requests singleflight.Group
+}
+
+// Token returns the cached access token or requests a new one if the cached token expires soon
+//
+// The request for a new token is shared with concurrent calls, so it doesn't use the context
+// of the caller that started it and isn't cancelled with it. Each caller stops waiting when its context is done.
+func (s *CachingTokenSource) Token(ctx context.Context) (*TokenResponse, error) {
+	if token := s.cached(); token != nil {
+		return token, nil
+	}
+
+	results := s.requests.DoChan("token", func() (interface{}, error) {
+		requestCtx, cancel := context.WithTimeout(context.Background(), tokenRequestTimeout)
+		defer cancel()
+
+		token, err := s.source.Token(requestCtx)
+		if err != nil {
+			return nil, err
+		}
+		s.cache(token)
+		return token, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*TokenResponse), nil
+	}
+}
+
+// cached returns the cached token or nil if no token is cached or it expires soon.
+func (s *CachingTokenSource) cached() *TokenResponse {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != nil && s.now().Before(s.renewAt) {
+		return s.token
+	}
+
+	return nil
+}
+
+// cache caches the token until shortly before it expires.
+func (s *CachingTokenSource) cache(token *TokenResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	lifetime := tokenLifetime(token, now)
+	s.token = nil
+	if lifetime <= 0 {
+		return
+	}
+
+	// Short-lived tokens are renewed when half of their lifetime has passed.
+	delta := s.expiryDelta
+	if delta > lifetime/2 {
+		delta = lifetime / 2
+	}
+	s.token = token
+	s.renewAt = now.Add(lifetime - delta)
+}
+
+// tokenLifetime returns how long the token is valid. Without "expires_in", the "exp" claim of a JWT access token
+// is used, otherwise the default lifetime.
+func tokenLifetime(token *TokenResponse, now time.Time) time.Duration {
+	if token.ExpiresIn > 0 {
+		return time.Duration(token.ExpiresIn) * time.Second
+	}
+
+	// The token is not validated, its expiry only limits how long it is cached.
+	var claims jwt.MapClaims
+	if _, _, err := new(jwt.Parser).ParseUnverified(token.AccessToken, &claims); err == nil {
+		if exp, ok, err := timeClaim(claims, "exp"); err == nil && ok {
+			return exp.Sub(now)
+		}
+	}
+
+	return defaultTokenLifetime
+}
+
+// NewCachingTokenSource returns a new instance of [CachingTokenSource](#type-cachingtokensource)
+// The token is renewed expiryDelta before it expires (Default: 1m).
+func NewCachingTokenSource(source TokenSource, expiryDelta time.Duration) *CachingTokenSource {
+	return NewCachingTokenSourceWithBoundaries(source, expiryDelta, time.Now)
+}
+
+// NewCachingTokenSourceWithBoundaries returns a new instance of [CachingTokenSource](#type-cachingtokensource) with the provided boundaries
+func NewCachingTokenSourceWithBoundaries(source TokenSource, expiryDelta time.Duration, now Clock) *CachingTokenSource {
+	if expiryDelta <= 0 {
+		expiryDelta = defaultTokenExpiryDelta
+	}
+
+	return &CachingTokenSource{source: source, expiryDelta: expiryDelta, now: now}
+}
+
+// NewClientCredentialsTokenSource returns a [token source](#type-cachingtokensource) that requests access tokens
+// with the client credentials of the token retriever and caches them until shortly before they expire
+func NewClientCredentialsTokenSource(retriever *TokenRetriever, audience string, scope []string) *CachingTokenSource {
+	return NewCachingTokenSource(TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+		return retriever.ClientCredentials(ctx, audience, scope)
+	}), 0)
+}
@@ -0,0 +1,297 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// countingTokenSource returns numbered tokens and counts its calls.
+type countingTokenSource struct {
+	expiresIn int
+	err       error
+	calls     int
+}
+
+func (s *countingTokenSource) Token(ctx context.Context) (*TokenResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &TokenResponse{AccessToken: fmt.Sprintf("token-%d", s.calls), ExpiresIn: s.expiresIn}, nil
+}
+
+var _ = Describe("Token sources", func() {
+	ctx := context.Background()
+
+	Describe("when a token is requested with client credentials", func() {
+		var server *httptest.Server
+		var retriever *TokenRetriever
+		var status int
+		var body string
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			body = `{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600}`
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(r.FormValue("grant_type")).To(Equal("client_credentials"))
+				Expect(r.FormValue("client_id")).To(Equal("service"))
+				Expect(r.FormValue("client_secret")).To(Equal("secret"))
+				Expect(r.FormValue("audience")).To(Equal("https://api.couchconnections"))
+				Expect(r.FormValue("scope")).To(Equal("events:read events:write"))
+
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(body))
+			}))
+			retriever = NewTokenRetriever(&TokenRetrieverConfig{
+				ClientID:     "service",
+				ClientSecret: "secret",
+				TokenURL:     server.URL,
+			}, server.Client())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should return the access token", func() {
+			token, err := retriever.ClientCredentials(ctx, "https://api.couchconnections", []string{"events:read", "events:write"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("access-token"))
+			Expect(token.ExpiresIn).To(Equal(3600))
+		})
+
+		It("should return the error of the token endpoint", func() {
+			status = http.StatusBadRequest
+			body = `{"error": "invalid_client", "error_description": "unknown client"}`
+
+			_, err := retriever.ClientCredentials(ctx, "https://api.couchconnections", []string{"events:read", "events:write"})
+
+			Expect(err).To(BeAssignableToTypeOf(&TokenEndpointError{}))
+		})
+
+		It("should return an error if the request failed without an error description", func() {
+			status = http.StatusBadGateway
+			body = "<html>Bad Gateway</html>"
+
+			_, err := retriever.ClientCredentials(ctx, "https://api.couchconnections", []string{"events:read", "events:write"})
+
+			Expect(err).To(MatchError("Wrong response of the token endpoint. Status code: 502"))
+		})
+
+		It("should return an error if the response can't be decoded", func() {
+			body = "not json"
+
+			_, err := retriever.ClientCredentials(ctx, "https://api.couchconnections", []string{"events:read", "events:write"})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("Error decoding token response"))
+		})
+	})
+
+	Describe("when tokens are cached", func() {
+		var source *countingTokenSource
+		var cache *CachingTokenSource
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+			source = &countingTokenSource{expiresIn: 3600}
+			cache = NewCachingTokenSourceWithBoundaries(source, time.Minute, func() time.Time { return now })
+		})
+
+		It("should reuse the token until shortly before it expires", func() {
+			token, err := cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("token-1"))
+
+			now = now.Add(58 * time.Minute)
+			token, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("token-1"))
+
+			now = now.Add(time.Minute)
+			token, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("token-2"))
+		})
+
+		It("should renew short-lived tokens when half of their lifetime has passed", func() {
+			source.expiresIn = 60
+			_, err := cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(29 * time.Second)
+			_, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.calls).To(Equal(1))
+
+			now = now.Add(time.Second)
+			_, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.calls).To(Equal(2))
+		})
+
+		It("should cache tokens without expiry for the default lifetime", func() {
+			source.expiresIn = 0
+			_, err := cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(3*time.Minute + 59*time.Second)
+			_, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.calls).To(Equal(1))
+
+			now = now.Add(time.Second)
+			_, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.calls).To(Equal(2))
+		})
+
+		It("should cache JWT access tokens without expires_in until their exp claim", func() {
+			accessToken := signClaims(jwt.SigningMethodHS256, "", []byte("secret"), jwt.MapClaims{
+				"exp": now.Add(time.Hour).Unix(),
+			})
+			calls := 0
+			cache = NewCachingTokenSourceWithBoundaries(TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+				calls++
+				return &TokenResponse{AccessToken: accessToken}, nil
+			}), time.Minute, func() time.Time { return now })
+
+			_, err := cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			now = now.Add(58 * time.Minute)
+			_, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal(1))
+
+			now = now.Add(time.Minute)
+			_, err = cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal(2))
+		})
+
+		It("should share one request between concurrent calls", func() {
+			release := make(chan struct{})
+			var calls int32
+			cache = NewCachingTokenSourceWithBoundaries(TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return &TokenResponse{AccessToken: "token", ExpiresIn: 3600}, nil
+			}), time.Minute, func() time.Time { return now })
+
+			tokens := make(chan *TokenResponse, 3)
+			for i := 0; i < 3; i++ {
+				go func() {
+					defer GinkgoRecover()
+					token, err := cache.Token(ctx)
+					Expect(err).ToNot(HaveOccurred())
+					tokens <- token
+				}()
+			}
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+			close(release)
+
+			for i := 0; i < 3; i++ {
+				var token *TokenResponse
+				Eventually(tokens).Should(Receive(&token))
+				Expect(token.AccessToken).To(Equal("token"))
+			}
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+		})
+
+		It("should stop waiting when the context is done without cancelling the shared request", func() {
+			release := make(chan struct{})
+			requestErrs := make(chan error, 1)
+			cache = NewCachingTokenSourceWithBoundaries(TokenSourceFunc(func(ctx context.Context) (*TokenResponse, error) {
+				<-release
+				requestErrs <- ctx.Err()
+				return &TokenResponse{AccessToken: "token", ExpiresIn: 3600}, nil
+			}), time.Minute, func() time.Time { return now })
+
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err := cache.Token(cancelledCtx)
+			Expect(err).To(MatchError(context.Canceled))
+
+			close(release)
+			Eventually(requestErrs).Should(Receive(BeNil()))
+			token, err := cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("token"))
+		})
+
+		It("should not cache errors", func() {
+			source.err = errors.New("unavailable")
+			_, err := cache.Token(ctx)
+			Expect(err).To(MatchError("unavailable"))
+
+			source.err = nil
+			token, err := cache.Token(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("token-2"))
+		})
+	})
+
+	Describe("when gRPC calls are authorized", func() {
+		It("should attach the token as authorization header", func() {
+			credentials := NewTokenCredentials(&countingTokenSource{expiresIn: 3600}, false)
+
+			md, err := credentials.GetRequestMetadata(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(md).To(Equal(map[string]string{"authorization": "Bearer token-1"}))
+			Expect(credentials.RequireTransportSecurity()).To(BeTrue())
+		})
+	})
+
+	Describe("when HTTP requests are authorized", func() {
+		var server *httptest.Server
+		var authorization string
+
+		BeforeEach(func() {
+			authorization = ""
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should attach the token without changing the request", func() {
+			client := &http.Client{Transport: NewTokenTransport(&countingTokenSource{expiresIn: 3600}, nil)}
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := client.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+
+			Expect(authorization).To(Equal("Bearer token-1"))
+			Expect(req.Header.Get("Authorization")).To(BeEmpty())
+		})
+
+		It("should not send the request without a token", func() {
+			client := &http.Client{Transport: NewTokenTransport(&countingTokenSource{err: errors.New("unavailable")}, nil)}
+
+			_, err := client.Get(server.URL)
+
+			Expect(err).To(HaveOccurred())
+			Expect(authorization).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+// TokenValidator validates access tokens
+type TokenValidator interface {
+	// ValidateAccessToken validates the access token and returns its [claims](#type-accesstokenclaims)
+	ValidateAccessToken(ctx context.Context, accessToken string) (*AccessTokenClaims, error)
+}
+
+// TokenValidatorChain validates access tokens with the first of its validators that accepts them,
+// e.g. JWTs locally and opaque tokens with the introspection endpoint.
+// Use NewTokenValidatorChain to build.
+type TokenValidatorChain struct {
+	validators []TokenValidator
+}
+
+// ValidateAccessToken returns the claims of the first validator that accepts the token.
+// If all validators reject the token, the error of the first validator that could read the token is returned,
+// so that e.g. an expired JWT is not reported as malformed by a later validator.
+func (c *TokenValidatorChain) ValidateAccessToken(ctx context.Context, accessToken string) (*AccessTokenClaims, error) {
+	var firstErr, lastErr error
+	for _, validator := range c.validators {
+		claims, err := validator.ValidateAccessToken(ctx, accessToken)
+		if err == nil {
+			return claims, nil
+		}
+		if _, malformed := err.(*MalformedTokenError); !malformed && firstErr == nil {
+			firstErr = err
+		}
+		lastErr = err
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, errors.New("No token validator configured")
+}
+
+// NewTokenValidatorChain returns a new instance of [TokenValidatorChain](#type-tokenvalidatorchain)
+// that tries the validators in the given order
+func NewTokenValidatorChain(validators ...TokenValidator) *TokenValidatorChain {
+	return &TokenValidatorChain{validators: validators}
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// staticTokenValidator returns the same claims or error for every token.
+type staticTokenValidator struct {
+	claims *AccessTokenClaims
+	err    error
+	calls  int
+}
+
+func (v *staticTokenValidator) ValidateAccessToken(ctx context.Context, accessToken string) (*AccessTokenClaims, error) {
+	v.calls++
+	return v.claims, v.err
+}
+
+var _ = Describe("Token validator chain", func() {
+	ctx := context.Background()
+
+	It("should return the claims of the first validator that accepts the token", func() {
+		jwt := &staticTokenValidator{err: NewMalformedTokenError(errors.New("not a JWT"))}
+		introspection := &staticTokenValidator{claims: &AccessTokenClaims{Sub: "anna"}}
+		unused := &staticTokenValidator{claims: &AccessTokenClaims{Sub: "bob"}}
+
+		claims, err := NewTokenValidatorChain(jwt, introspection, unused).ValidateAccessToken(ctx, "token")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(claims.Sub).To(Equal("anna"))
+		Expect(unused.calls).To(Equal(0))
+	})
+
+	It("should return the error of the first validator that could read the token", func() {
+		jwt := &staticTokenValidator{err: NewTokenExpiredError(time.Time{})}
+		introspection := &staticTokenValidator{err: NewInactiveTokenError()}
+
+		_, err := NewTokenValidatorChain(jwt, introspection).ValidateAccessToken(ctx, "token")
+
+		Expect(err).To(BeAssignableToTypeOf(&TokenExpiredError{}))
+	})
+
+	It("should return the last error if no validator could read the token", func() {
+		jwt := &staticTokenValidator{err: NewMalformedTokenError(errors.New("not a JWT"))}
+
+		_, err := NewTokenValidatorChain(jwt).ValidateAccessToken(ctx, "token")
+
+		Expect(err).To(BeAssignableToTypeOf(&MalformedTokenError{}))
+	})
+
+	It("should return an error without validators", func() {
+		_, err := NewTokenValidatorChain().ValidateAccessToken(ctx, "token")
+
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultUserInfoCacheMaxEntries = 10000
+	defaultUserInfoCacheTTL        = 5 * time.Minute
+
+	// userInfoRequestTimeout limits the request to the provider that is shared by concurrent lookups of a token.
+	userInfoRequestTimeout = 10 * time.Second
+)
+
+// UserInfoProvider provides the [user information](#type-userinforesponse) of an access token
+type UserInfoProvider interface {
+	// GetUserInfo returns the user information of the access token
+	GetUserInfo(ctx context.Context, accessToken string) (*UserInfoResponse, error)
+}
+
+// UserInfoCacheConfig contains the configurations for the user info cache
+type UserInfoCacheConfig struct {
+	// MaxEntries is the maximum number of cached tokens. The least recently used token is evicted first (Default: 10000)
+	MaxEntries int
+	// TTL is how long the user info of a token is cached. The user info is never cached after the token expired (Default: 5m)
+	TTL time.Duration
+}
+
+// UserInfoCache caches the user information of access tokens in memory,
+// so that the identity provider is not called for every request.
+// The tokens are only kept as hashes. Concurrent lookups of the same token share one request to the identity provider.
+// Use NewUserInfoCache to build.
+type UserInfoCache struct {
+	provider UserInfoProvider
+	config   UserInfoCacheConfig
+	now      Clock
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	// lru holds the cached entries, the most recently used first.
+	lru *list.List
+
+	requests singleflight.Group
+
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+type userInfoCacheEntry struct {
+	tokenHash string
+	userInfo  *UserInfoResponse
+	expiresAt time.Time
+}
+
+// GetUserInfo returns the cached user information of the access token
+// or queries the provider if the token is not cached. expiresAt is the expiry of the token; zero if it doesn't expire.
+// Errors are not cached.
+//
+// The request to the provider is shared with concurrent lookups of the token, so it doesn't use the context
+// of the caller that started it and isn't cancelled with it. Each caller stops waiting when its context is done.
+func (c *UserInfoCache) GetUserInfo(ctx context.Context, accessToken string, expiresAt time.Time) (*UserInfoResponse, error) {
+	tokenHash := hashAccessToken(accessToken)
+
+	if userInfo := c.get(tokenHash); userInfo != nil {
+		c.hits.Inc()
+		return userInfo, nil
+	}
+	c.misses.Inc()
+
+	results := c.requests.DoChan(tokenHash, func() (interface{}, error) {
+		requestCtx, cancel := context.WithTimeout(context.Background(), userInfoRequestTimeout)
+		defer cancel()
+
+		userInfo, err := c.provider.GetUserInfo(requestCtx, accessToken)
+		if err != nil {
+			return nil, err
+		}
+		c.add(tokenHash, userInfo, expiresAt)
+		return userInfo, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*UserInfoResponse), nil
+	}
+}
+
+// get returns the user info of the token hash or nil if it isn't cached or expired.
+func (c *UserInfoCache) get(tokenHash string) *UserInfoResponse {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[tokenHash]
+	if !ok {
+		return nil
+	}
+	entry := element.Value.(*userInfoCacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil
+	}
+	c.lru.MoveToFront(element)
+
+	return entry.userInfo
+}
+
+// add caches the user info of the token hash until the TTL passed or the token expires, whichever is first.
+func (c *UserInfoCache) add(tokenHash string, userInfo *UserInfoResponse, tokenExpiresAt time.Time) {
+	expiresAt := c.now().Add(c.config.TTL)
+	if !tokenExpiresAt.IsZero() && tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+	if !c.now().Before(expiresAt) {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[tokenHash]; ok {
+		c.remove(element)
+	}
+	c.entries[tokenHash] = c.lru.PushFront(&userInfoCacheEntry{
+		tokenHash: tokenHash,
+		userInfo:  userInfo,
+		expiresAt: expiresAt,
+	})
+	for c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove deletes a cached entry. The mutex must be held.
+func (c *UserInfoCache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*userInfoCacheEntry).tokenHash)
+}
+
+// hashAccessToken returns the key of an access token in the cache.
+func hashAccessToken(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(hash[:])
+}
+
+// NewUserInfoCache returns a new instance of [UserInfoCache](#type-userinfocache)
+// The hit and miss counters are registered with the registerer, unless it is nil.
+func NewUserInfoCache(provider UserInfoProvider, config *UserInfoCacheConfig, registerer prometheus.Registerer) (*UserInfoCache, error) {
+	return NewUserInfoCacheWithBoundaries(provider, config, registerer, time.Now)
+}
+
+// NewUserInfoCacheWithBoundaries returns a new instance of [UserInfoCache](#type-userinfocache) with the provided boundaries
+func NewUserInfoCacheWithBoundaries(provider UserInfoProvider, config *UserInfoCacheConfig, registerer prometheus.Registerer, now Clock) (*UserInfoCache, error) {
+	c := *config
+	if c.MaxEntries <= 0 {
+		c.MaxEntries = defaultUserInfoCacheMaxEntries
+	}
+	if c.TTL <= 0 {
+		c.TTL = defaultUserInfoCacheTTL
+	}
+
+	cache := &UserInfoCache{
+		provider: provider,
+		config:   c,
+		now:      now,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "couchconnections",
+			Subsystem: "userinfo_cache",
+			Name:      "hits_total",
+			Help:      "Number of authenticated requests whose user info was cached.",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "couchconnections",
+			Subsystem: "userinfo_cache",
+			Name:      "misses_total",
+			Help:      "Number of authenticated requests whose user info was not cached.",
+		}),
+	}
+
+	if registerer != nil {
+		for _, collector := range []prometheus.Collector{cache.hits, cache.misses} {
+			if err := registerer.Register(collector); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return cache, nil
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// countingUserInfoProvider returns the token as subject and counts its calls.
+type countingUserInfoProvider struct {
+	mutex   sync.Mutex
+	calls   int
+	err     error
+	release chan struct{}
+}
+
+func (p *countingUserInfoProvider) GetUserInfo(ctx context.Context, accessToken string) (*UserInfoResponse, error) {
+	p.mutex.Lock()
+	p.calls++
+	release, err := p.release, p.err
+	p.mutex.Unlock()
+
+	if release != nil {
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &UserInfoResponse{Sub: accessToken}, nil
+}
+
+func (p *countingUserInfoProvider) callCount() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.calls
+}
+
+var _ = Describe("User info cache", func() {
+	var provider *countingUserInfoProvider
+	var cache *UserInfoCache
+	var now time.Time
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+		provider = &countingUserInfoProvider{}
+
+		var err error
+		cache, err = NewUserInfoCacheWithBoundaries(provider, &UserInfoCacheConfig{
+			MaxEntries: 2,
+			TTL:        5 * time.Minute,
+		}, prometheus.NewRegistry(), func() time.Time { return now })
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("when the user info of a token is requested", func() {
+		It("should request it once", func() {
+			userInfo, err := cache.GetUserInfo(ctx, "token-1", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(userInfo.Sub).To(Equal("token-1"))
+
+			userInfo, err = cache.GetUserInfo(ctx, "token-1", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(userInfo.Sub).To(Equal("token-1"))
+
+			Expect(provider.callCount()).To(Equal(1))
+			Expect(testutil.ToFloat64(cache.hits)).To(Equal(1.0))
+			Expect(testutil.ToFloat64(cache.misses)).To(Equal(1.0))
+		})
+
+		It("should request it again when the TTL has passed", func() {
+			_, err := cache.GetUserInfo(ctx, "token-1", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(5 * time.Minute)
+			_, err = cache.GetUserInfo(ctx, "token-1", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(provider.callCount()).To(Equal(2))
+		})
+
+		It("should request it again when the token has expired", func() {
+			_, err := cache.GetUserInfo(ctx, "token-1", now.Add(time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(time.Minute)
+			_, err = cache.GetUserInfo(ctx, "token-1", now.Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(provider.callCount()).To(Equal(2))
+		})
+
+		It("should not cache the user info of expired tokens", func() {
+			_, err := cache.GetUserInfo(ctx, "token-1", now)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = cache.GetUserInfo(ctx, "token-1", now)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(provider.callCount()).To(Equal(2))
+		})
+
+		It("should not cache errors", func() {
+			provider.err = errors.New("unavailable")
+			_, err := cache.GetUserInfo(ctx, "token-1", time.Time{})
+			Expect(err).To(MatchError("unavailable"))
+
+			provider.err = nil
+			_, err = cache.GetUserInfo(ctx, "token-1", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(provider.callCount()).To(Equal(2))
+		})
+	})
+
+	Describe("when more tokens are used than the cache holds", func() {
+		It("should evict the least recently used token", func() {
+			for _, token := range []string{"token-1", "token-2", "token-1", "token-3", "token-1", "token-2"} {
+				_, err := cache.GetUserInfo(ctx, token, time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// token-2 is evicted by token-3 and requested again.
+			Expect(provider.callCount()).To(Equal(4))
+		})
+	})
+
+	Describe("when the same token is requested concurrently", func() {
+		It("should request the user info once", func() {
+			provider.release = make(chan struct{})
+
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					userInfo, err := cache.GetUserInfo(ctx, "token-1", time.Time{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(userInfo.Sub).To(Equal("token-1"))
+				}()
+			}
+
+			Eventually(func() float64 { return testutil.ToFloat64(cache.misses) }).Should(Equal(5.0))
+			Consistently(provider.callCount, 50*time.Millisecond).Should(Equal(1))
+			close(provider.release)
+			wg.Wait()
+
+			Expect(provider.callCount()).To(Equal(1))
+		})
+	})
+
+	Describe("when the caller that started the request is cancelled", func() {
+		It("should still return the user info to the other callers", func() {
+			provider.release = make(chan struct{})
+			cancelCtx, cancel := context.WithCancel(ctx)
+
+			cancelled := make(chan error, 1)
+			go func() {
+				_, err := cache.GetUserInfo(cancelCtx, "token-1", time.Time{})
+				cancelled <- err
+			}()
+			Eventually(provider.callCount).Should(Equal(1))
+
+			waiting := make(chan *UserInfoResponse, 1)
+			go func() {
+				defer GinkgoRecover()
+
+				userInfo, err := cache.GetUserInfo(ctx, "token-1", time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+				waiting <- userInfo
+			}()
+			Eventually(func() float64 { return testutil.ToFloat64(cache.misses) }).Should(Equal(2.0))
+
+			cancel()
+			Eventually(cancelled).Should(Receive(Equal(context.Canceled)))
+			close(provider.release)
+
+			var userInfo *UserInfoResponse
+			Eventually(waiting).Should(Receive(&userInfo))
+			Expect(userInfo.Sub).To(Equal("token-1"))
+			Expect(provider.callCount()).To(Equal(1))
+		})
+	})
+
+	Describe("when the metrics are registered twice", func() {
+		It("should return an error", func() {
+			registry := prometheus.NewRegistry()
+			_, err := NewUserInfoCache(provider, &UserInfoCacheConfig{}, registry)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = NewUserInfoCache(provider, &UserInfoCacheConfig{}, registry)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
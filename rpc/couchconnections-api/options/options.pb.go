@@ -0,0 +1,97 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: options/options.proto
+
+package options
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Permission is the capability permission that a caller needs to invoke a method.
+type Permission int32
+
+const (
+	// PERMISSION_UNSPECIFIED allows every authenticated caller to invoke the method.
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// PERMISSION_READ requires the read, write or admin permission of the capability.
+	Permission_PERMISSION_READ Permission = 1
+	// PERMISSION_WRITE requires the write or admin permission of the capability.
+	Permission_PERMISSION_WRITE Permission = 2
+	// PERMISSION_ADMIN requires the admin permission of the capability.
+	Permission_PERMISSION_ADMIN Permission = 3
+)
+
+var Permission_name = map[int32]string{
+	0: "PERMISSION_UNSPECIFIED",
+	1: "PERMISSION_READ",
+	2: "PERMISSION_WRITE",
+	3: "PERMISSION_ADMIN",
+}
+
+var Permission_value = map[string]int32{
+	"PERMISSION_UNSPECIFIED": 0,
+	"PERMISSION_READ":        1,
+	"PERMISSION_WRITE":       2,
+	"PERMISSION_ADMIN":       3,
+}
+
+func (x Permission) String() string {
+	return proto.EnumName(Permission_name, int32(x))
+}
+
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fa3ac5190829870e, []int{0}
+}
+
+var E_RequiredPermission = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MethodOptions)(nil),
+	ExtensionType: (*Permission)(nil),
+	Field:         50001,
+	Name:          "couchconnections.required_permission",
+	Tag:           "varint,50001,opt,name=required_permission,enum=couchconnections.Permission",
+	Filename:      "options/options.proto",
+}
+
+func init() {
+	proto.RegisterEnum("couchconnections.Permission", Permission_name, Permission_value)
+	proto.RegisterExtension(E_RequiredPermission)
+}
+
+func init() {
+	proto.RegisterFile("options/options.proto", fileDescriptor_fa3ac5190829870e)
+}
+
+var fileDescriptor_fa3ac5190829870e = []byte{
+	// 260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0xc7, 0x9d, 0x03, 0x0f, 0x39, 0x68, 0xe8, 0x54, 0x64, 0x88, 0xec, 0x28, 0x82, 0x09, 0xe8,
+	0x4d, 0x4f, 0xd3, 0x46, 0xc8, 0xa1, 0x5d, 0x69, 0x1d, 0x82, 0x97, 0xd1, 0xa6, 0xb1, 0x0d, 0xb8,
+	0xbc, 0x98, 0xa4, 0x5f, 0xc1, 0xef, 0xe7, 0x37, 0x12, 0xda, 0x95, 0x8e, 0xee, 0xf4, 0xe0, 0xf7,
+	0xc8, 0x3f, 0xbf, 0xff, 0x43, 0x17, 0x60, 0xbc, 0x02, 0xed, 0xe8, 0x6e, 0x12, 0x63, 0xc1, 0x43,
+	0x80, 0x05, 0x34, 0xa2, 0x16, 0xa0, 0xb5, 0x14, 0x2d, 0x9f, 0x2f, 0x2a, 0x80, 0xea, 0x5b, 0xd2,
+	0x76, 0x5f, 0x34, 0x5f, 0xb4, 0x94, 0x4e, 0x58, 0x65, 0x3c, 0xd8, 0xee, 0xcd, 0x9d, 0x42, 0x28,
+	0x91, 0x76, 0xab, 0x9c, 0x53, 0xa0, 0x83, 0x39, 0xba, 0x4c, 0x58, 0x1a, 0xf1, 0x2c, 0xe3, 0xab,
+	0x78, 0xb3, 0x8e, 0xb3, 0x84, 0xbd, 0xf2, 0x37, 0xce, 0x42, 0x7c, 0x14, 0xcc, 0xd0, 0xd9, 0xde,
+	0x2e, 0x65, 0xcb, 0x10, 0x4f, 0x82, 0x73, 0x84, 0xf7, 0xe0, 0x47, 0xca, 0xdf, 0x19, 0x3e, 0x1e,
+	0xd1, 0x65, 0x18, 0xf1, 0x18, 0x4f, 0x9f, 0x00, 0xcd, 0xac, 0xfc, 0x69, 0x94, 0x95, 0xe5, 0xc6,
+	0x0c, 0x7f, 0xde, 0x90, 0x4e, 0x92, 0xf4, 0x92, 0x24, 0x92, 0xbe, 0x86, 0x72, 0xd5, 0x75, 0xbb,
+	0xfa, 0xfb, 0x9d, 0x2e, 0x26, 0xb7, 0xa7, 0x0f, 0xd7, 0x64, 0x5c, 0x8f, 0x0c, 0xe6, 0x69, 0xd0,
+	0x47, 0x0f, 0xec, 0x65, 0xfd, 0x99, 0x55, 0xca, 0xd7, 0x4d, 0x41, 0x04, 0x6c, 0xa9, 0x93, 0x45,
+	0xee, 0xbc, 0xca, 0xb5, 0x05, 0x27, 0x6a, 0x3a, 0x0e, 0xa3, 0xd6, 0x88, 0x03, 0x78, 0x9f, 0x1b,
+	0xd5, 0x1f, 0xf9, 0x79, 0x37, 0x8b, 0x93, 0x56, 0xf4, 0xf1, 0x7f, 0x00, 0xcd, 0xe4, 0xf2, 0xd3,
+	0x86, 0x01, 0x00, 0x00,
+}
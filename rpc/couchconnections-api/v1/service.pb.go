@@ -6,9 +6,13 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "github.com/sebastianrosch/couchconnections/rpc/couchconnections-api/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,6 +31,154 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// The status of an event.
+type EventStatus int32
+
+const (
+	// The status is not specified.
+	EventStatus_EVENT_STATUS_UNSPECIFIED EventStatus = 0
+	// The event is a draft and not yet scheduled.
+	EventStatus_EVENT_STATUS_DRAFT EventStatus = 1
+	// The event is scheduled.
+	EventStatus_EVENT_STATUS_SCHEDULED EventStatus = 2
+	// The event is currently live.
+	EventStatus_EVENT_STATUS_LIVE EventStatus = 3
+	// The event has finished.
+	EventStatus_EVENT_STATUS_FINISHED EventStatus = 4
+	// The event was cancelled.
+	EventStatus_EVENT_STATUS_CANCELLED EventStatus = 5
+	// The event was submitted and waits for the review of an admin.
+	EventStatus_EVENT_STATUS_PENDING EventStatus = 6
+	// The event was rejected by an admin.
+	EventStatus_EVENT_STATUS_REJECTED EventStatus = 7
+)
+
+var EventStatus_name = map[int32]string{
+	0: "EVENT_STATUS_UNSPECIFIED",
+	1: "EVENT_STATUS_DRAFT",
+	2: "EVENT_STATUS_SCHEDULED",
+	3: "EVENT_STATUS_LIVE",
+	4: "EVENT_STATUS_FINISHED",
+	5: "EVENT_STATUS_CANCELLED",
+	6: "EVENT_STATUS_PENDING",
+	7: "EVENT_STATUS_REJECTED",
+}
+
+var EventStatus_value = map[string]int32{
+	"EVENT_STATUS_UNSPECIFIED": 0,
+	"EVENT_STATUS_DRAFT":       1,
+	"EVENT_STATUS_SCHEDULED":   2,
+	"EVENT_STATUS_LIVE":        3,
+	"EVENT_STATUS_FINISHED":    4,
+	"EVENT_STATUS_CANCELLED":   5,
+	"EVENT_STATUS_PENDING":     6,
+	"EVENT_STATUS_REJECTED":    7,
+}
+
+func (x EventStatus) String() string {
+	return proto.EnumName(EventStatus_name, int32(x))
+}
+
+func (EventStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{0}
+}
+
+// The order of listed events.
+type EventOrder int32
+
+const (
+	// The events are ordered by start time, earliest first.
+	EventOrder_EVENT_ORDER_UNSPECIFIED EventOrder = 0
+	// The events are ordered by start time, earliest first.
+	EventOrder_EVENT_ORDER_START_ASCENDING EventOrder = 1
+	// The events are ordered by start time, latest first.
+	EventOrder_EVENT_ORDER_START_DESCENDING EventOrder = 2
+)
+
+var EventOrder_name = map[int32]string{
+	0: "EVENT_ORDER_UNSPECIFIED",
+	1: "EVENT_ORDER_START_ASCENDING",
+	2: "EVENT_ORDER_START_DESCENDING",
+}
+
+var EventOrder_value = map[string]int32{
+	"EVENT_ORDER_UNSPECIFIED":      0,
+	"EVENT_ORDER_START_ASCENDING":  1,
+	"EVENT_ORDER_START_DESCENDING": 2,
+}
+
+func (x EventOrder) String() string {
+	return proto.EnumName(EventOrder_name, int32(x))
+}
+
+func (EventOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{1}
+}
+
+// The occurrences of a series that are changed by an update or delete.
+type RecurrenceScope int32
+
+const (
+	// Only the given occurrence is changed.
+	RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED RecurrenceScope = 0
+	// Only the given occurrence is changed.
+	RecurrenceScope_RECURRENCE_SCOPE_THIS_OCCURRENCE RecurrenceScope = 1
+	// The given occurrence and all following occurrences are changed.
+	RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING RecurrenceScope = 2
+)
+
+var RecurrenceScope_name = map[int32]string{
+	0: "RECURRENCE_SCOPE_UNSPECIFIED",
+	1: "RECURRENCE_SCOPE_THIS_OCCURRENCE",
+	2: "RECURRENCE_SCOPE_THIS_AND_FOLLOWING",
+}
+
+var RecurrenceScope_value = map[string]int32{
+	"RECURRENCE_SCOPE_UNSPECIFIED":        0,
+	"RECURRENCE_SCOPE_THIS_OCCURRENCE":    1,
+	"RECURRENCE_SCOPE_THIS_AND_FOLLOWING": 2,
+}
+
+func (x RecurrenceScope) String() string {
+	return proto.EnumName(RecurrenceScope_name, int32(x))
+}
+
+func (RecurrenceScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{2}
+}
+
+// The status of a registration.
+type RegistrationStatus int32
+
+const (
+	// The status is not specified.
+	RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED RegistrationStatus = 0
+	// The registration is confirmed and the attendee has a seat.
+	RegistrationStatus_REGISTRATION_STATUS_CONFIRMED RegistrationStatus = 1
+	// The event is fully booked and the attendee is on the waitlist.
+	RegistrationStatus_REGISTRATION_STATUS_WAITLISTED RegistrationStatus = 2
+)
+
+var RegistrationStatus_name = map[int32]string{
+	0: "REGISTRATION_STATUS_UNSPECIFIED",
+	1: "REGISTRATION_STATUS_CONFIRMED",
+	2: "REGISTRATION_STATUS_WAITLISTED",
+}
+
+var RegistrationStatus_value = map[string]int32{
+	"REGISTRATION_STATUS_UNSPECIFIED": 0,
+	"REGISTRATION_STATUS_CONFIRMED":   1,
+	"REGISTRATION_STATUS_WAITLISTED":  2,
+}
+
+func (x RegistrationStatus) String() string {
+	return proto.EnumName(RegistrationStatus_name, int32(x))
+}
+
+func (RegistrationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{3}
+}
+
 // The API version.
 type Version struct {
 	// The build version.
@@ -86,133 +238,3840 @@ func (m *Version) GetRevision() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Version)(nil), "v1.Version")
+// An event hosted in a living room.
+type Event struct {
+	// The unique identifier of the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The topic of the event.
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The description of the event.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The host of the event.
+	Host string `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	// The Zoom link to join the event. Only returned to the owner and to confirmed attendees shortly before the event.
+	ZoomLink string `protobuf:"bytes,5,opt,name=zoom_link,json=zoomLink,proto3" json:"zoom_link,omitempty"`
+	// The start time of the event.
+	Start *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	// The end time of the event. Must be after the start time.
+	End *timestamp.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	// The duration of the event.
+	Duration *duration.Duration `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The IANA time zone the event is presented in, e.g. Europe/Berlin.
+	TimeZone string `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The language of the presentation as BCP 47 language tag, e.g. en or de-CH.
+	Language string `protobuf:"bytes,10,opt,name=language,proto3" json:"language,omitempty"`
+	// The maximum number of attendees. Zero means unlimited.
+	Capacity uint32 `protobuf:"varint,11,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// The status of the event.
+	Status EventStatus `protobuf:"varint,12,opt,name=status,proto3,enum=v1.EventStatus" json:"status,omitempty"`
+	// The ID of the user who created the event. Set by the server.
+	OwnerId string `protobuf:"bytes,13,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// The recurrence rule of the series as defined in RFC 5545, e.g. FREQ=WEEKLY;BYDAY=WE.
+	Recurrence string `protobuf:"bytes,14,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// The ID of the series if the event is an occurrence of a series. Set by the server.
+	SeriesId string `protobuf:"bytes,15,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// The free tags of the event, e.g. corona or fintech. Tags are stored in lower case.
+	Tags []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The ID of the category of the event.
+	CategoryId string `protobuf:"bytes,17,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// The ID of the profile of the host presenting the event.
+	HostId string `protobuf:"bytes,18,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// The IDs of the profiles of the co-hosts of the event.
+	CoHostIds []string `protobuf:"bytes,19,rep,name=co_host_ids,json=coHostIds,proto3" json:"co_host_ids,omitempty"`
+	// The reason an admin gave for rejecting the event. Set by the server.
+	RejectionReason      string   `protobuf:"bytes,20,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("v1/service.proto", fileDescriptor_d3e34d69331f2f1a)
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{1}
 }
 
-var fileDescriptor_d3e34d69331f2f1a = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xfd, 0xe2, 0x54, 0x6d, 0x3a, 0xd5, 0xa7, 0xf6, 0x1b, 0x7d, 0xa0, 0x2a, 0x6d, 0x61, 0xb0,
-	0x58, 0xa0, 0xa8, 0x1d, 0x27, 0xee, 0x8f, 0xaa, 0x54, 0x80, 0xdc, 0x0a, 0xa1, 0xb2, 0xaa, 0x22,
-	0xc4, 0xa2, 0xbb, 0xf1, 0xe4, 0x26, 0x1e, 0xe4, 0xcc, 0xb8, 0x33, 0x63, 0x97, 0x0a, 0xb1, 0x81,
-	0x37, 0x08, 0x1b, 0xc4, 0x13, 0xb0, 0x64, 0xc5, 0x8b, 0xf0, 0x0a, 0x3c, 0x08, 0xca, 0xd8, 0xa6,
-	0x51, 0x8b, 0xba, 0xb2, 0xe7, 0x9c, 0x7b, 0xee, 0x3d, 0x67, 0xee, 0xa0, 0xb5, 0xa2, 0x17, 0x18,
-	0xd0, 0x85, 0xe0, 0x40, 0x33, 0xad, 0xac, 0xc2, 0x5e, 0xd1, 0x6b, 0x6f, 0x8e, 0x95, 0x1a, 0xa7,
-	0x10, 0xb0, 0x4c, 0x04, 0x4c, 0x4a, 0x65, 0x99, 0x15, 0x4a, 0x9a, 0xb2, 0xa2, 0xbd, 0x51, 0xb1,
-	0xee, 0x14, 0xe7, 0xa3, 0x00, 0x26, 0x99, 0xbd, 0xaa, 0xc8, 0x6d, 0xf7, 0xe1, 0x3b, 0x63, 0x90,
-	0x3b, 0xe6, 0x92, 0x8d, 0xc7, 0xa0, 0x03, 0x95, 0x39, 0xf9, 0xed, 0x56, 0xfe, 0x0f, 0x0f, 0x2d,
-	0xbd, 0x01, 0x6d, 0x84, 0x92, 0xf8, 0x08, 0x2d, 0x15, 0xe5, 0xef, 0x7a, 0x83, 0x34, 0x9e, 0x2c,
-	0x1f, 0x3f, 0x9a, 0x46, 0x0f, 0xc2, 0xcd, 0xd7, 0x09, 0x90, 0x38, 0x17, 0xe9, 0x90, 0x54, 0x2c,
-	0x51, 0x23, 0x62, 0x13, 0x20, 0xd1, 0xd9, 0xe9, 0xa0, 0x56, 0xe0, 0x43, 0xb4, 0x18, 0x6b, 0x26,
-	0x79, 0xb2, 0xee, 0x39, 0x2d, 0x99, 0x46, 0x5b, 0xe1, 0xc6, 0xb5, 0xb6, 0x24, 0xe7, 0xa5, 0x55,
-	0x3d, 0x7e, 0x86, 0x5a, 0x1a, 0x0a, 0xe1, 0xe6, 0x36, 0x9d, 0xd6, 0x9f, 0x46, 0x0f, 0xc3, 0xad,
-	0x6b, 0x6d, 0x4d, 0xcf, 0xab, 0xff, 0x68, 0xfa, 0x76, 0x1a, 0x5d, 0xa0, 0x4e, 0x67, 0x25, 0x3a,
-	0x3b, 0xad, 0x1d, 0x96, 0x83, 0xe7, 0x00, 0x22, 0xe4, 0x48, 0xe9, 0x89, 0x8b, 0x1e, 0x9e, 0xe0,
-	0xe8, 0x3d, 0xf1, 0x2b, 0xc6, 0xef, 0x13, 0xbf, 0x4b, 0xbb, 0xb4, 0xe7, 0x6f, 0x13, 0xbf, 0x74,
-	0x34, 0x83, 0x26, 0xcc, 0x58, 0xd0, 0x33, 0xac, 0x9e, 0xe3, 0x0a, 0xf9, 0xee, 0x70, 0xb4, 0x7f,
-	0xe0, 0x93, 0x0f, 0xe1, 0x97, 0x06, 0x5a, 0x3b, 0x51, 0x39, 0x4f, 0x4e, 0x94, 0x94, 0xc0, 0xdd,
-	0x9d, 0xe2, 0x4f, 0x0d, 0x84, 0x5e, 0x82, 0xad, 0x2f, 0xf4, 0x3e, 0x2d, 0x17, 0x45, 0xeb, 0x45,
-	0xd1, 0x17, 0xb3, 0x45, 0xb5, 0x57, 0x68, 0xd1, 0xa3, 0x55, 0x91, 0x7f, 0x36, 0x8d, 0x9e, 0xa2,
-	0xd6, 0xa9, 0xb4, 0xa0, 0x25, 0x4b, 0xb1, 0x8b, 0x51, 0x71, 0xed, 0xc7, 0x03, 0xb0, 0xb9, 0x96,
-	0xa6, 0x4e, 0xfd, 0xb7, 0x38, 0xf4, 0xe3, 0xcf, 0x5f, 0x9f, 0x3d, 0x84, 0x5b, 0x41, 0x45, 0x1e,
-	0x7f, 0x6f, 0x4e, 0xa3, 0x6f, 0x4d, 0x9c, 0xa0, 0x7b, 0xce, 0x20, 0x99, 0x73, 0x38, 0x6b, 0xe2,
-	0xbf, 0x42, 0xff, 0xf3, 0x19, 0xc1, 0xaf, 0xf1, 0x1d, 0x96, 0x09, 0x1c, 0x26, 0xd6, 0x66, 0xa6,
-	0x1f, 0x04, 0x63, 0x61, 0x93, 0x3c, 0xa6, 0x5c, 0x4d, 0x02, 0x03, 0x31, 0x33, 0x56, 0x30, 0xa9,
-	0x95, 0xe1, 0x49, 0x70, 0x53, 0x17, 0x36, 0x7b, 0xb4, 0xeb, 0x2f, 0xcc, 0xde, 0x69, 0xc7, 0x6b,
-	0x78, 0xe1, 0x1a, 0xcb, 0xb2, 0x54, 0x70, 0x67, 0x2c, 0x78, 0x6b, 0x94, 0xec, 0xdf, 0x42, 0x06,
-	0x47, 0xa8, 0xb9, 0xd7, 0xdd, 0xc3, 0x7b, 0xa8, 0x53, 0xa6, 0x83, 0x21, 0xb9, 0x4c, 0x40, 0xba,
-	0x8c, 0x1a, 0x8c, 0xca, 0x35, 0x07, 0x32, 0x54, 0x60, 0x88, 0x54, 0x96, 0xc0, 0x3b, 0x61, 0x2c,
-	0xc5, 0x8b, 0x68, 0xe1, 0xab, 0xd7, 0x58, 0x1a, 0x3c, 0x47, 0xcd, 0xfd, 0xee, 0x2e, 0x3e, 0x44,
-	0x07, 0x77, 0x88, 0x85, 0x21, 0x16, 0x26, 0x99, 0xd2, 0x4c, 0x8b, 0xf4, 0x8a, 0xe4, 0x92, 0x15,
-	0x4c, 0xa4, 0x2c, 0x4e, 0x81, 0x0e, 0xd2, 0xd9, 0xf4, 0x1e, 0x06, 0xc4, 0xef, 0x68, 0xa0, 0xe1,
-	0x22, 0x17, 0x1a, 0x0c, 0x61, 0xb9, 0x4d, 0x40, 0xda, 0xca, 0x3f, 0x61, 0x72, 0x48, 0xa4, 0xba,
-	0x89, 0xce, 0x2d, 0x83, 0x5c, 0x82, 0x06, 0x92, 0x69, 0x55, 0x88, 0x21, 0x0c, 0xe9, 0xf9, 0x7f,
-	0x68, 0x15, 0x2d, 0x1f, 0x33, 0x23, 0x78, 0x94, 0xdb, 0x04, 0x7b, 0xad, 0x46, 0xbc, 0x8a, 0xfe,
-	0x9d, 0x87, 0xfe, 0x39, 0xf7, 0x8a, 0x5e, 0xbc, 0xe8, 0x5e, 0xc8, 0xee, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x62, 0x38, 0x51, 0x4b, 0x0e, 0x04, 0x00, 0x00,
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+var xxx_messageInfo_Event proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (m *Event) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
 
-// CouchConnectionsClient is the client API for CouchConnections service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CouchConnectionsClient interface {
-	// GetVersion returns the API version.
-	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Version, error)
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
 }
 
-type couchConnectionsClient struct {
-	cc grpc.ClientConnInterface
+func (m *Event) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func NewCouchConnectionsClient(cc grpc.ClientConnInterface) CouchConnectionsClient {
-	return &couchConnectionsClient{cc}
+func (m *Event) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
 }
 
-func (c *couchConnectionsClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Version, error) {
-	out := new(Version)
-	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Event) GetZoomLink() string {
+	if m != nil {
+		return m.ZoomLink
 	}
-	return out, nil
+	return ""
 }
 
-// CouchConnectionsServer is the server API for CouchConnections service.
-type CouchConnectionsServer interface {
-	// GetVersion returns the API version.
-	GetVersion(context.Context, *empty.Empty) (*Version, error)
+func (m *Event) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
 }
 
-// UnimplementedCouchConnectionsServer can be embedded to have forward compatible implementations.
-type UnimplementedCouchConnectionsServer struct {
+func (m *Event) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
 }
 
-func (*UnimplementedCouchConnectionsServer) GetVersion(ctx context.Context, req *empty.Empty) (*Version, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+func (m *Event) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
 }
 
-func RegisterCouchConnectionsServer(s *grpc.Server, srv CouchConnectionsServer) {
-	s.RegisterService(&_CouchConnections_serviceDesc, srv)
+func (m *Event) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
 }
 
-func _CouchConnections_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Event) GetLanguage() string {
+	if m != nil {
+		return m.Language
 	}
-	if interceptor == nil {
-		return srv.(CouchConnectionsServer).GetVersion(ctx, in)
+	return ""
+}
+
+func (m *Event) GetCapacity() uint32 {
+	if m != nil {
+		return m.Capacity
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/v1.CouchConnections/GetVersion",
+	return 0
+}
+
+func (m *Event) GetStatus() EventStatus {
+	if m != nil {
+		return m.Status
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CouchConnectionsServer).GetVersion(ctx, req.(*empty.Empty))
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *Event) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _CouchConnections_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "v1.CouchConnections",
-	HandlerType: (*CouchConnectionsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetVersion",
-			Handler:    _CouchConnections_GetVersion_Handler,
+func (m *Event) GetRecurrence() string {
+	if m != nil {
+		return m.Recurrence
+	}
+	return ""
+}
+
+func (m *Event) GetSeriesId() string {
+	if m != nil {
+		return m.SeriesId
+	}
+	return ""
+}
+
+func (m *Event) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Event) GetCategoryId() string {
+	if m != nil {
+		return m.CategoryId
+	}
+	return ""
+}
+
+func (m *Event) GetHostId() string {
+	if m != nil {
+		return m.HostId
+	}
+	return ""
+}
+
+func (m *Event) GetCoHostIds() []string {
+	if m != nil {
+		return m.CoHostIds
+	}
+	return nil
+}
+
+func (m *Event) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+// The request to create an event.
+type CreateEventRequest struct {
+	// The event to create.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Creates the event even if it conflicts with other events of the same host or with the same link. Requires admin permissions.
+	IgnoreConflicts      bool     `protobuf:"varint,2,opt,name=ignore_conflicts,json=ignoreConflicts,proto3" json:"ignore_conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateEventRequest) Reset()         { *m = CreateEventRequest{} }
+func (m *CreateEventRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateEventRequest) ProtoMessage()    {}
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{2}
+}
+
+func (m *CreateEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateEventRequest.Unmarshal(m, b)
+}
+func (m *CreateEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateEventRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateEventRequest.Merge(m, src)
+}
+func (m *CreateEventRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateEventRequest.Size(m)
+}
+func (m *CreateEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateEventRequest proto.InternalMessageInfo
+
+func (m *CreateEventRequest) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *CreateEventRequest) GetIgnoreConflicts() bool {
+	if m != nil {
+		return m.IgnoreConflicts
+	}
+	return false
+}
+
+// The request to get an event.
+type GetEventRequest struct {
+	// The ID of the event.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventRequest) Reset()         { *m = GetEventRequest{} }
+func (m *GetEventRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventRequest) ProtoMessage()    {}
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{3}
+}
+
+func (m *GetEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventRequest.Unmarshal(m, b)
+}
+func (m *GetEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventRequest.Merge(m, src)
+}
+func (m *GetEventRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventRequest.Size(m)
+}
+func (m *GetEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventRequest proto.InternalMessageInfo
+
+func (m *GetEventRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// The request to list events.
+type ListEventsRequest struct {
+	// Only events that end after this time are returned.
+	From *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Only events that start before this time are returned.
+	// Occurrences of series are returned up to one year after from or now if this is not set.
+	To *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// The maximum number of events to return. Defaults to 100 and is capped at 500.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response to return the next page.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only events of this host are returned.
+	Host string `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	// Only events with this status are returned.
+	Status EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=v1.EventStatus" json:"status,omitempty"`
+	// Only events in this language are returned.
+	Language string `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	// The order of the events.
+	OrderBy EventOrder `protobuf:"varint,8,opt,name=order_by,json=orderBy,proto3,enum=v1.EventOrder" json:"order_by,omitempty"`
+	// Only events with this tag are returned.
+	Tag string `protobuf:"bytes,9,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only events in this category are returned.
+	CategoryId           string   `protobuf:"bytes,10,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()    {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{4}
+}
+
+func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
+}
+func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsRequest.Merge(m, src)
+}
+func (m *ListEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventsRequest.Size(m)
+}
+func (m *ListEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
+
+func (m *ListEventsRequest) GetFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ListEventsRequest) GetTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ListEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetStatus() EventStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *ListEventsRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetOrderBy() EventOrder {
+	if m != nil {
+		return m.OrderBy
+	}
+	return EventOrder_EVENT_ORDER_UNSPECIFIED
+}
+
+func (m *ListEventsRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetCategoryId() string {
+	if m != nil {
+		return m.CategoryId
+	}
+	return ""
+}
+
+// The response with a list of events.
+type ListEventsResponse struct {
+	// The events.
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// The token to request the next page or empty if there are no more events.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
+func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse) ProtoMessage()    {}
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{5}
+}
+
+func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
+}
+func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse.Merge(m, src)
+}
+func (m *ListEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse.Size(m)
+}
+func (m *ListEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
+
+func (m *ListEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListEventsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// The request to update an event.
+type UpdateEventRequest struct {
+	// The ID of the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The updated event.
+	Event *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// The occurrences to update if the event is an occurrence of a series.
+	Scope RecurrenceScope `protobuf:"varint,3,opt,name=scope,proto3,enum=v1.RecurrenceScope" json:"scope,omitempty"`
+	// Updates the event even if it conflicts with other events of the same host or with the same link. Requires admin permissions.
+	IgnoreConflicts      bool     `protobuf:"varint,4,opt,name=ignore_conflicts,json=ignoreConflicts,proto3" json:"ignore_conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateEventRequest) Reset()         { *m = UpdateEventRequest{} }
+func (m *UpdateEventRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateEventRequest) ProtoMessage()    {}
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{6}
+}
+
+func (m *UpdateEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateEventRequest.Unmarshal(m, b)
+}
+func (m *UpdateEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateEventRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateEventRequest.Merge(m, src)
+}
+func (m *UpdateEventRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateEventRequest.Size(m)
+}
+func (m *UpdateEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateEventRequest proto.InternalMessageInfo
+
+func (m *UpdateEventRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateEventRequest) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *UpdateEventRequest) GetScope() RecurrenceScope {
+	if m != nil {
+		return m.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+func (m *UpdateEventRequest) GetIgnoreConflicts() bool {
+	if m != nil {
+		return m.IgnoreConflicts
+	}
+	return false
+}
+
+// The request to delete an event.
+type DeleteEventRequest struct {
+	// The ID of the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The occurrences to delete if the event is an occurrence of a series.
+	Scope                RecurrenceScope `protobuf:"varint,2,opt,name=scope,proto3,enum=v1.RecurrenceScope" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DeleteEventRequest) Reset()         { *m = DeleteEventRequest{} }
+func (m *DeleteEventRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteEventRequest) ProtoMessage()    {}
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{7}
+}
+
+func (m *DeleteEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteEventRequest.Unmarshal(m, b)
+}
+func (m *DeleteEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteEventRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteEventRequest.Merge(m, src)
+}
+func (m *DeleteEventRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteEventRequest.Size(m)
+}
+func (m *DeleteEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteEventRequest proto.InternalMessageInfo
+
+func (m *DeleteEventRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteEventRequest) GetScope() RecurrenceScope {
+	if m != nil {
+		return m.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+// The request to search events.
+type SearchEventsRequest struct {
+	// The search terms. Events that contain any of the terms in their topic, host or description are returned.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of results to return. Defaults to 20 and is capped at 100.
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchEventsRequest) Reset()         { *m = SearchEventsRequest{} }
+func (m *SearchEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchEventsRequest) ProtoMessage()    {}
+func (*SearchEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{8}
+}
+
+func (m *SearchEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchEventsRequest.Unmarshal(m, b)
+}
+func (m *SearchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchEventsRequest.Merge(m, src)
+}
+func (m *SearchEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchEventsRequest.Size(m)
+}
+func (m *SearchEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchEventsRequest proto.InternalMessageInfo
+
+func (m *SearchEventsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+// An event that matches a search query.
+type SearchResult struct {
+	// The event.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The relevance of the event. Results with a higher score match better.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The parts of the searchable fields that contain the search terms.
+	Highlights           []*Highlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SearchResult) Reset()         { *m = SearchResult{} }
+func (m *SearchResult) String() string { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()    {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{9}
+}
+
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResult.Unmarshal(m, b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return xxx_messageInfo_SearchResult.Size(m)
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SearchResult) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SearchResult) GetHighlights() []*Highlight {
+	if m != nil {
+		return m.Highlights
+	}
+	return nil
+}
+
+// A part of a field with the search terms enclosed in <em> and </em>.
+// The text of the field is HTML-escaped, so the highlight can be rendered as HTML.
+type Highlight struct {
+	// The name of the field, e.g. "topic".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The part of the field.
+	Snippet              string   `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Highlight) Reset()         { *m = Highlight{} }
+func (m *Highlight) String() string { return proto.CompactTextString(m) }
+func (*Highlight) ProtoMessage()    {}
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{10}
+}
+
+func (m *Highlight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Highlight.Unmarshal(m, b)
+}
+func (m *Highlight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Highlight.Marshal(b, m, deterministic)
+}
+func (m *Highlight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Highlight.Merge(m, src)
+}
+func (m *Highlight) XXX_Size() int {
+	return xxx_messageInfo_Highlight.Size(m)
+}
+func (m *Highlight) XXX_DiscardUnknown() {
+	xxx_messageInfo_Highlight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Highlight proto.InternalMessageInfo
+
+func (m *Highlight) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Highlight) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+// The response with the events that match a search query.
+type SearchEventsResponse struct {
+	// The results ordered by relevance.
+	Results              []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchEventsResponse) Reset()         { *m = SearchEventsResponse{} }
+func (m *SearchEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchEventsResponse) ProtoMessage()    {}
+func (*SearchEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{11}
+}
+
+func (m *SearchEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchEventsResponse.Unmarshal(m, b)
+}
+func (m *SearchEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchEventsResponse.Merge(m, src)
+}
+func (m *SearchEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchEventsResponse.Size(m)
+}
+func (m *SearchEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchEventsResponse proto.InternalMessageInfo
+
+func (m *SearchEventsResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// A category curated by admins to classify events.
+type Category struct {
+	// The unique identifier of the category.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the category, e.g. Health.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the category.
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Category) Reset()         { *m = Category{} }
+func (m *Category) String() string { return proto.CompactTextString(m) }
+func (*Category) ProtoMessage()    {}
+func (*Category) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{12}
+}
+
+func (m *Category) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Category.Unmarshal(m, b)
+}
+func (m *Category) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Category.Marshal(b, m, deterministic)
+}
+func (m *Category) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Category.Merge(m, src)
+}
+func (m *Category) XXX_Size() int {
+	return xxx_messageInfo_Category.Size(m)
+}
+func (m *Category) XXX_DiscardUnknown() {
+	xxx_messageInfo_Category.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Category proto.InternalMessageInfo
+
+func (m *Category) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Category) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Category) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// The request to create a category.
+type CreateCategoryRequest struct {
+	// The category to create.
+	Category             *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateCategoryRequest) Reset()         { *m = CreateCategoryRequest{} }
+func (m *CreateCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCategoryRequest) ProtoMessage()    {}
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{13}
+}
+
+func (m *CreateCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCategoryRequest.Unmarshal(m, b)
+}
+func (m *CreateCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCategoryRequest.Merge(m, src)
+}
+func (m *CreateCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCategoryRequest.Size(m)
+}
+func (m *CreateCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCategoryRequest proto.InternalMessageInfo
+
+func (m *CreateCategoryRequest) GetCategory() *Category {
+	if m != nil {
+		return m.Category
+	}
+	return nil
+}
+
+// The request to update a category.
+type UpdateCategoryRequest struct {
+	// The ID of the category.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The updated category.
+	Category             *Category `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpdateCategoryRequest) Reset()         { *m = UpdateCategoryRequest{} }
+func (m *UpdateCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCategoryRequest) ProtoMessage()    {}
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{14}
+}
+
+func (m *UpdateCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCategoryRequest.Unmarshal(m, b)
+}
+func (m *UpdateCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCategoryRequest.Merge(m, src)
+}
+func (m *UpdateCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCategoryRequest.Size(m)
+}
+func (m *UpdateCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCategoryRequest proto.InternalMessageInfo
+
+func (m *UpdateCategoryRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateCategoryRequest) GetCategory() *Category {
+	if m != nil {
+		return m.Category
+	}
+	return nil
+}
+
+// The request to delete a category.
+type DeleteCategoryRequest struct {
+	// The ID of the category.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCategoryRequest) Reset()         { *m = DeleteCategoryRequest{} }
+func (m *DeleteCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCategoryRequest) ProtoMessage()    {}
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{15}
+}
+
+func (m *DeleteCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCategoryRequest.Unmarshal(m, b)
+}
+func (m *DeleteCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCategoryRequest.Merge(m, src)
+}
+func (m *DeleteCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCategoryRequest.Size(m)
+}
+func (m *DeleteCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCategoryRequest proto.InternalMessageInfo
+
+func (m *DeleteCategoryRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// The response with all categories.
+type ListCategoriesResponse struct {
+	// The categories ordered by name.
+	Categories           []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListCategoriesResponse) Reset()         { *m = ListCategoriesResponse{} }
+func (m *ListCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCategoriesResponse) ProtoMessage()    {}
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{16}
+}
+
+func (m *ListCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCategoriesResponse.Unmarshal(m, b)
+}
+func (m *ListCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCategoriesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCategoriesResponse.Merge(m, src)
+}
+func (m *ListCategoriesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCategoriesResponse.Size(m)
+}
+func (m *ListCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCategoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCategoriesResponse proto.InternalMessageInfo
+
+func (m *ListCategoriesResponse) GetCategories() []*Category {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+// The request to get the tag cloud.
+type GetTagCloudRequest struct {
+	// The maximum number of tags to return. Defaults to 50 and is capped at 200.
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTagCloudRequest) Reset()         { *m = GetTagCloudRequest{} }
+func (m *GetTagCloudRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTagCloudRequest) ProtoMessage()    {}
+func (*GetTagCloudRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{17}
+}
+
+func (m *GetTagCloudRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTagCloudRequest.Unmarshal(m, b)
+}
+func (m *GetTagCloudRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTagCloudRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTagCloudRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTagCloudRequest.Merge(m, src)
+}
+func (m *GetTagCloudRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTagCloudRequest.Size(m)
+}
+func (m *GetTagCloudRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTagCloudRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTagCloudRequest proto.InternalMessageInfo
+
+func (m *GetTagCloudRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// The number of upcoming events with a tag.
+type TagCount struct {
+	// The tag.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The number of upcoming events with the tag.
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagCount) Reset()         { *m = TagCount{} }
+func (m *TagCount) String() string { return proto.CompactTextString(m) }
+func (*TagCount) ProtoMessage()    {}
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{18}
+}
+
+func (m *TagCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagCount.Unmarshal(m, b)
+}
+func (m *TagCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagCount.Marshal(b, m, deterministic)
+}
+func (m *TagCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagCount.Merge(m, src)
+}
+func (m *TagCount) XXX_Size() int {
+	return xxx_messageInfo_TagCount.Size(m)
+}
+func (m *TagCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagCount proto.InternalMessageInfo
+
+func (m *TagCount) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// The most used tags of upcoming events.
+type TagCloud struct {
+	// The tags ordered by the number of upcoming events.
+	Tags                 []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TagCloud) Reset()         { *m = TagCloud{} }
+func (m *TagCloud) String() string { return proto.CompactTextString(m) }
+func (*TagCloud) ProtoMessage()    {}
+func (*TagCloud) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{19}
+}
+
+func (m *TagCloud) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagCloud.Unmarshal(m, b)
+}
+func (m *TagCloud) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagCloud.Marshal(b, m, deterministic)
+}
+func (m *TagCloud) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagCloud.Merge(m, src)
+}
+func (m *TagCloud) XXX_Size() int {
+	return xxx_messageInfo_TagCloud.Size(m)
+}
+func (m *TagCloud) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagCloud.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagCloud proto.InternalMessageInfo
+
+func (m *TagCloud) GetTags() []*TagCount {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// The public profile of a user who presents events.
+type Host struct {
+	// The unique identifier of the host.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user. Set by the server.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The name of the host. Defaults to the name of the user.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// A short biography of the host.
+	Bio string `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	// The URL of the avatar of the host. Defaults to the picture of the user.
+	AvatarUrl string `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Links to websites or social media accounts of the host.
+	Links []*HostLink `protobuf:"bytes,6,rep,name=links,proto3" json:"links,omitempty"`
+	// The time the profile was last updated. Set by the server.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Host) Reset()         { *m = Host{} }
+func (m *Host) String() string { return proto.CompactTextString(m) }
+func (*Host) ProtoMessage()    {}
+func (*Host) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{20}
+}
+
+func (m *Host) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Host.Unmarshal(m, b)
+}
+func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Host.Marshal(b, m, deterministic)
+}
+func (m *Host) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Host.Merge(m, src)
+}
+func (m *Host) XXX_Size() int {
+	return xxx_messageInfo_Host.Size(m)
+}
+func (m *Host) XXX_DiscardUnknown() {
+	xxx_messageInfo_Host.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Host proto.InternalMessageInfo
+
+func (m *Host) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Host) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Host) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Host) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *Host) GetAvatarUrl() string {
+	if m != nil {
+		return m.AvatarUrl
+	}
+	return ""
+}
+
+func (m *Host) GetLinks() []*HostLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *Host) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+// A link on the profile of a host.
+type HostLink struct {
+	// The title of the link, e.g. Twitter.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The URL of the link.
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HostLink) Reset()         { *m = HostLink{} }
+func (m *HostLink) String() string { return proto.CompactTextString(m) }
+func (*HostLink) ProtoMessage()    {}
+func (*HostLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{21}
+}
+
+func (m *HostLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HostLink.Unmarshal(m, b)
+}
+func (m *HostLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HostLink.Marshal(b, m, deterministic)
+}
+func (m *HostLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostLink.Merge(m, src)
+}
+func (m *HostLink) XXX_Size() int {
+	return xxx_messageInfo_HostLink.Size(m)
+}
+func (m *HostLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostLink proto.InternalMessageInfo
+
+func (m *HostLink) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *HostLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// The request to update the host profile of the authenticated user.
+type UpdateMyHostProfileRequest struct {
+	// The updated profile.
+	Host                 *Host    `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateMyHostProfileRequest) Reset()         { *m = UpdateMyHostProfileRequest{} }
+func (m *UpdateMyHostProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateMyHostProfileRequest) ProtoMessage()    {}
+func (*UpdateMyHostProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{22}
+}
+
+func (m *UpdateMyHostProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateMyHostProfileRequest.Unmarshal(m, b)
+}
+func (m *UpdateMyHostProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateMyHostProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateMyHostProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMyHostProfileRequest.Merge(m, src)
+}
+func (m *UpdateMyHostProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateMyHostProfileRequest.Size(m)
+}
+func (m *UpdateMyHostProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMyHostProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMyHostProfileRequest proto.InternalMessageInfo
+
+func (m *UpdateMyHostProfileRequest) GetHost() *Host {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
+// The request to get a host.
+type GetHostRequest struct {
+	// The ID of the host.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHostRequest) Reset()         { *m = GetHostRequest{} }
+func (m *GetHostRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHostRequest) ProtoMessage()    {}
+func (*GetHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{23}
+}
+
+func (m *GetHostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHostRequest.Unmarshal(m, b)
+}
+func (m *GetHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHostRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostRequest.Merge(m, src)
+}
+func (m *GetHostRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHostRequest.Size(m)
+}
+func (m *GetHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHostRequest proto.InternalMessageInfo
+
+func (m *GetHostRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// The request to list the events of a host.
+type ListHostEventsRequest struct {
+	// The ID of the host.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The maximum number of upcoming and of past events to return. Defaults to 20 and is capped at 100.
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHostEventsRequest) Reset()         { *m = ListHostEventsRequest{} }
+func (m *ListHostEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHostEventsRequest) ProtoMessage()    {}
+func (*ListHostEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{24}
+}
+
+func (m *ListHostEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHostEventsRequest.Unmarshal(m, b)
+}
+func (m *ListHostEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHostEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListHostEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHostEventsRequest.Merge(m, src)
+}
+func (m *ListHostEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListHostEventsRequest.Size(m)
+}
+func (m *ListHostEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHostEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHostEventsRequest proto.InternalMessageInfo
+
+func (m *ListHostEventsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListHostEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+// The response with the events of a host.
+type ListHostEventsResponse struct {
+	// The host.
+	Host *Host `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// The events that haven't ended yet, earliest first.
+	Upcoming []*Event `protobuf:"bytes,2,rep,name=upcoming,proto3" json:"upcoming,omitempty"`
+	// The events that have ended, latest first.
+	Past                 []*Event `protobuf:"bytes,3,rep,name=past,proto3" json:"past,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHostEventsResponse) Reset()         { *m = ListHostEventsResponse{} }
+func (m *ListHostEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListHostEventsResponse) ProtoMessage()    {}
+func (*ListHostEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{25}
+}
+
+func (m *ListHostEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHostEventsResponse.Unmarshal(m, b)
+}
+func (m *ListHostEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHostEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListHostEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHostEventsResponse.Merge(m, src)
+}
+func (m *ListHostEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListHostEventsResponse.Size(m)
+}
+func (m *ListHostEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHostEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHostEventsResponse proto.InternalMessageInfo
+
+func (m *ListHostEventsResponse) GetHost() *Host {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
+func (m *ListHostEventsResponse) GetUpcoming() []*Event {
+	if m != nil {
+		return m.Upcoming
+	}
+	return nil
+}
+
+func (m *ListHostEventsResponse) GetPast() []*Event {
+	if m != nil {
+		return m.Past
+	}
+	return nil
+}
+
+// The request to list the events that wait for review.
+type ListPendingEventsRequest struct {
+	// The maximum number of events to return. Defaults to 100 and is capped at 500.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response to return the next page.
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPendingEventsRequest) Reset()         { *m = ListPendingEventsRequest{} }
+func (m *ListPendingEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPendingEventsRequest) ProtoMessage()    {}
+func (*ListPendingEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{26}
+}
+
+func (m *ListPendingEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingEventsRequest.Unmarshal(m, b)
+}
+func (m *ListPendingEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPendingEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingEventsRequest.Merge(m, src)
+}
+func (m *ListPendingEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPendingEventsRequest.Size(m)
+}
+func (m *ListPendingEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingEventsRequest proto.InternalMessageInfo
+
+func (m *ListPendingEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListPendingEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// The request to approve a pending event.
+type ApproveEventRequest struct {
+	// The ID of the event. Approving an occurrence approves the whole series.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveEventRequest) Reset()         { *m = ApproveEventRequest{} }
+func (m *ApproveEventRequest) String() string { return proto.CompactTextString(m) }
+func (*ApproveEventRequest) ProtoMessage()    {}
+func (*ApproveEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{27}
+}
+
+func (m *ApproveEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveEventRequest.Unmarshal(m, b)
+}
+func (m *ApproveEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveEventRequest.Marshal(b, m, deterministic)
+}
+func (m *ApproveEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveEventRequest.Merge(m, src)
+}
+func (m *ApproveEventRequest) XXX_Size() int {
+	return xxx_messageInfo_ApproveEventRequest.Size(m)
+}
+func (m *ApproveEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveEventRequest proto.InternalMessageInfo
+
+func (m *ApproveEventRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// The request to reject a pending event.
+type RejectEventRequest struct {
+	// The ID of the event. Rejecting an occurrence rejects the whole series.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The reason for the rejection, which is shown to the owner of the event.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectEventRequest) Reset()         { *m = RejectEventRequest{} }
+func (m *RejectEventRequest) String() string { return proto.CompactTextString(m) }
+func (*RejectEventRequest) ProtoMessage()    {}
+func (*RejectEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{28}
+}
+
+func (m *RejectEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectEventRequest.Unmarshal(m, b)
+}
+func (m *RejectEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectEventRequest.Marshal(b, m, deterministic)
+}
+func (m *RejectEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectEventRequest.Merge(m, src)
+}
+func (m *RejectEventRequest) XXX_Size() int {
+	return xxx_messageInfo_RejectEventRequest.Size(m)
+}
+func (m *RejectEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectEventRequest proto.InternalMessageInfo
+
+func (m *RejectEventRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RejectEventRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// The request to get the join link of an event.
+type GetJoinLinkRequest struct {
+	// The ID of the event.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJoinLinkRequest) Reset()         { *m = GetJoinLinkRequest{} }
+func (m *GetJoinLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJoinLinkRequest) ProtoMessage()    {}
+func (*GetJoinLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{29}
+}
+
+func (m *GetJoinLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJoinLinkRequest.Unmarshal(m, b)
+}
+func (m *GetJoinLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJoinLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJoinLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJoinLinkRequest.Merge(m, src)
+}
+func (m *GetJoinLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJoinLinkRequest.Size(m)
+}
+func (m *GetJoinLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJoinLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJoinLinkRequest proto.InternalMessageInfo
+
+func (m *GetJoinLinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// The link to join an event.
+type JoinLink struct {
+	// The ID of the event.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The Zoom link to join the event.
+	ZoomLink             string   `protobuf:"bytes,2,opt,name=zoom_link,json=zoomLink,proto3" json:"zoom_link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinLink) Reset()         { *m = JoinLink{} }
+func (m *JoinLink) String() string { return proto.CompactTextString(m) }
+func (*JoinLink) ProtoMessage()    {}
+func (*JoinLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{30}
+}
+
+func (m *JoinLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinLink.Unmarshal(m, b)
+}
+func (m *JoinLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinLink.Marshal(b, m, deterministic)
+}
+func (m *JoinLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinLink.Merge(m, src)
+}
+func (m *JoinLink) XXX_Size() int {
+	return xxx_messageInfo_JoinLink.Size(m)
+}
+func (m *JoinLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinLink proto.InternalMessageInfo
+
+func (m *JoinLink) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *JoinLink) GetZoomLink() string {
+	if m != nil {
+		return m.ZoomLink
+	}
+	return ""
+}
+
+// The registration of a user for an event.
+type Registration struct {
+	// The unique identifier of the registration.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the event.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The ID of the registered user.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The name of the registered user.
+	UserName string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The status of the registration.
+	Status RegistrationStatus `protobuf:"varint,5,opt,name=status,proto3,enum=v1.RegistrationStatus" json:"status,omitempty"`
+	// The time the registration was made.
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Registration) Reset()         { *m = Registration{} }
+func (m *Registration) String() string { return proto.CompactTextString(m) }
+func (*Registration) ProtoMessage()    {}
+func (*Registration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{31}
+}
+
+func (m *Registration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Registration.Unmarshal(m, b)
+}
+func (m *Registration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Registration.Marshal(b, m, deterministic)
+}
+func (m *Registration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Registration.Merge(m, src)
+}
+func (m *Registration) XXX_Size() int {
+	return xxx_messageInfo_Registration.Size(m)
+}
+func (m *Registration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Registration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Registration proto.InternalMessageInfo
+
+func (m *Registration) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Registration) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *Registration) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Registration) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *Registration) GetStatus() RegistrationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED
+}
+
+func (m *Registration) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+// The request to register for an event.
+type RegisterForEventRequest struct {
+	// The ID of the event.
+	EventId              string   `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterForEventRequest) Reset()         { *m = RegisterForEventRequest{} }
+func (m *RegisterForEventRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterForEventRequest) ProtoMessage()    {}
+func (*RegisterForEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{32}
+}
+
+func (m *RegisterForEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterForEventRequest.Unmarshal(m, b)
+}
+func (m *RegisterForEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterForEventRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterForEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterForEventRequest.Merge(m, src)
+}
+func (m *RegisterForEventRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterForEventRequest.Size(m)
+}
+func (m *RegisterForEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterForEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterForEventRequest proto.InternalMessageInfo
+
+func (m *RegisterForEventRequest) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+// The request to cancel the registration for an event.
+type CancelRegistrationRequest struct {
+	// The ID of the event.
+	EventId              string   `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelRegistrationRequest) Reset()         { *m = CancelRegistrationRequest{} }
+func (m *CancelRegistrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRegistrationRequest) ProtoMessage()    {}
+func (*CancelRegistrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{33}
+}
+
+func (m *CancelRegistrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelRegistrationRequest.Unmarshal(m, b)
+}
+func (m *CancelRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelRegistrationRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelRegistrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRegistrationRequest.Merge(m, src)
+}
+func (m *CancelRegistrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelRegistrationRequest.Size(m)
+}
+func (m *CancelRegistrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRegistrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRegistrationRequest proto.InternalMessageInfo
+
+func (m *CancelRegistrationRequest) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+// The request to list the registrations for an event.
+type ListRegistrationsRequest struct {
+	// The ID of the event.
+	EventId              string   `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRegistrationsRequest) Reset()         { *m = ListRegistrationsRequest{} }
+func (m *ListRegistrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRegistrationsRequest) ProtoMessage()    {}
+func (*ListRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{34}
+}
+
+func (m *ListRegistrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRegistrationsRequest.Unmarshal(m, b)
+}
+func (m *ListRegistrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRegistrationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRegistrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRegistrationsRequest.Merge(m, src)
+}
+func (m *ListRegistrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRegistrationsRequest.Size(m)
+}
+func (m *ListRegistrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRegistrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRegistrationsRequest proto.InternalMessageInfo
+
+func (m *ListRegistrationsRequest) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+// The response with a list of registrations.
+type ListRegistrationsResponse struct {
+	// The registrations in the order they were made.
+	Registrations        []*Registration `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListRegistrationsResponse) Reset()         { *m = ListRegistrationsResponse{} }
+func (m *ListRegistrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRegistrationsResponse) ProtoMessage()    {}
+func (*ListRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{35}
+}
+
+func (m *ListRegistrationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRegistrationsResponse.Unmarshal(m, b)
+}
+func (m *ListRegistrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRegistrationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRegistrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRegistrationsResponse.Merge(m, src)
+}
+func (m *ListRegistrationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRegistrationsResponse.Size(m)
+}
+func (m *ListRegistrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRegistrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRegistrationsResponse proto.InternalMessageInfo
+
+func (m *ListRegistrationsResponse) GetRegistrations() []*Registration {
+	if m != nil {
+		return m.Registrations
+	}
+	return nil
+}
+
+// The secret token to subscribe to the personal calendar feed.
+type FeedToken struct {
+	// The secret token. It is only returned once and can't be retrieved later.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The path of the calendar feed, relative to the API host.
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeedToken) Reset()         { *m = FeedToken{} }
+func (m *FeedToken) String() string { return proto.CompactTextString(m) }
+func (*FeedToken) ProtoMessage()    {}
+func (*FeedToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{36}
+}
+
+func (m *FeedToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeedToken.Unmarshal(m, b)
+}
+func (m *FeedToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeedToken.Marshal(b, m, deterministic)
+}
+func (m *FeedToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedToken.Merge(m, src)
+}
+func (m *FeedToken) XXX_Size() int {
+	return xxx_messageInfo_FeedToken.Size(m)
+}
+func (m *FeedToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedToken proto.InternalMessageInfo
+
+func (m *FeedToken) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *FeedToken) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// A personal API key that authenticates scripts and bots as the user who created it.
+type APIKey struct {
+	// The unique identifier of the key.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the key, e.g. the name of the script that uses it.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The first characters of the key to identify it. Set by the server.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The permissions granted to requests with the key, e.g. capability:couchconnections:write. They must be granted to the user.
+	// Permissions that are later revoked from the user are revoked from the key once the user signs in again.
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// The time the key was created. Set by the server.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The time the key expires, at most 90 days after it was created. If empty, the key expires after 90 days.
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The time the key was last used, accurate to a minute. Set by the server.
+	LastUsedAt           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *APIKey) Reset()         { *m = APIKey{} }
+func (m *APIKey) String() string { return proto.CompactTextString(m) }
+func (*APIKey) ProtoMessage()    {}
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{37}
+}
+
+func (m *APIKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKey.Unmarshal(m, b)
+}
+func (m *APIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKey.Marshal(b, m, deterministic)
+}
+func (m *APIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKey.Merge(m, src)
+}
+func (m *APIKey) XXX_Size() int {
+	return xxx_messageInfo_APIKey.Size(m)
+}
+func (m *APIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKey proto.InternalMessageInfo
+
+func (m *APIKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *APIKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *APIKey) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *APIKey) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *APIKey) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetLastUsedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return nil
+}
+
+// The request to create an API key.
+type CreateAPIKeyRequest struct {
+	// The API key to create.
+	ApiKey               *APIKey  `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyRequest) Reset()         { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()    {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{38}
+}
+
+func (m *CreateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyRequest.Merge(m, src)
+}
+func (m *CreateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyRequest.Size(m)
+}
+func (m *CreateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *CreateAPIKeyRequest) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+// The response with the created API key.
+type CreateAPIKeyResponse struct {
+	// The created API key.
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The secret key. It is only returned once and can't be retrieved later.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyResponse) Reset()         { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()    {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{39}
+}
+
+func (m *CreateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyResponse.Merge(m, src)
+}
+func (m *CreateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyResponse.Size(m)
+}
+func (m *CreateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyResponse proto.InternalMessageInfo
+
+func (m *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// The response with the API keys of the authenticated user.
+type ListAPIKeysResponse struct {
+	// The API keys in the order they were created.
+	ApiKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListAPIKeysResponse) Reset()         { *m = ListAPIKeysResponse{} }
+func (m *ListAPIKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAPIKeysResponse) ProtoMessage()    {}
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{40}
+}
+
+func (m *ListAPIKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPIKeysResponse.Unmarshal(m, b)
+}
+func (m *ListAPIKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPIKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAPIKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPIKeysResponse.Merge(m, src)
+}
+func (m *ListAPIKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAPIKeysResponse.Size(m)
+}
+func (m *ListAPIKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPIKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAPIKeysResponse proto.InternalMessageInfo
+
+func (m *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+// The request to revoke an API key.
+type RevokeAPIKeyRequest struct {
+	// The ID of the API key.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3e34d69331f2f1a, []int{41}
+}
+
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(m, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("v1.EventStatus", EventStatus_name, EventStatus_value)
+	proto.RegisterEnum("v1.EventOrder", EventOrder_name, EventOrder_value)
+	proto.RegisterEnum("v1.RecurrenceScope", RecurrenceScope_name, RecurrenceScope_value)
+	proto.RegisterEnum("v1.RegistrationStatus", RegistrationStatus_name, RegistrationStatus_value)
+	proto.RegisterType((*Version)(nil), "v1.Version")
+	proto.RegisterType((*Event)(nil), "v1.Event")
+	proto.RegisterType((*CreateEventRequest)(nil), "v1.CreateEventRequest")
+	proto.RegisterType((*GetEventRequest)(nil), "v1.GetEventRequest")
+	proto.RegisterType((*ListEventsRequest)(nil), "v1.ListEventsRequest")
+	proto.RegisterType((*ListEventsResponse)(nil), "v1.ListEventsResponse")
+	proto.RegisterType((*UpdateEventRequest)(nil), "v1.UpdateEventRequest")
+	proto.RegisterType((*DeleteEventRequest)(nil), "v1.DeleteEventRequest")
+	proto.RegisterType((*SearchEventsRequest)(nil), "v1.SearchEventsRequest")
+	proto.RegisterType((*SearchResult)(nil), "v1.SearchResult")
+	proto.RegisterType((*Highlight)(nil), "v1.Highlight")
+	proto.RegisterType((*SearchEventsResponse)(nil), "v1.SearchEventsResponse")
+	proto.RegisterType((*Category)(nil), "v1.Category")
+	proto.RegisterType((*CreateCategoryRequest)(nil), "v1.CreateCategoryRequest")
+	proto.RegisterType((*UpdateCategoryRequest)(nil), "v1.UpdateCategoryRequest")
+	proto.RegisterType((*DeleteCategoryRequest)(nil), "v1.DeleteCategoryRequest")
+	proto.RegisterType((*ListCategoriesResponse)(nil), "v1.ListCategoriesResponse")
+	proto.RegisterType((*GetTagCloudRequest)(nil), "v1.GetTagCloudRequest")
+	proto.RegisterType((*TagCount)(nil), "v1.TagCount")
+	proto.RegisterType((*TagCloud)(nil), "v1.TagCloud")
+	proto.RegisterType((*Host)(nil), "v1.Host")
+	proto.RegisterType((*HostLink)(nil), "v1.HostLink")
+	proto.RegisterType((*UpdateMyHostProfileRequest)(nil), "v1.UpdateMyHostProfileRequest")
+	proto.RegisterType((*GetHostRequest)(nil), "v1.GetHostRequest")
+	proto.RegisterType((*ListHostEventsRequest)(nil), "v1.ListHostEventsRequest")
+	proto.RegisterType((*ListHostEventsResponse)(nil), "v1.ListHostEventsResponse")
+	proto.RegisterType((*ListPendingEventsRequest)(nil), "v1.ListPendingEventsRequest")
+	proto.RegisterType((*ApproveEventRequest)(nil), "v1.ApproveEventRequest")
+	proto.RegisterType((*RejectEventRequest)(nil), "v1.RejectEventRequest")
+	proto.RegisterType((*GetJoinLinkRequest)(nil), "v1.GetJoinLinkRequest")
+	proto.RegisterType((*JoinLink)(nil), "v1.JoinLink")
+	proto.RegisterType((*Registration)(nil), "v1.Registration")
+	proto.RegisterType((*RegisterForEventRequest)(nil), "v1.RegisterForEventRequest")
+	proto.RegisterType((*CancelRegistrationRequest)(nil), "v1.CancelRegistrationRequest")
+	proto.RegisterType((*ListRegistrationsRequest)(nil), "v1.ListRegistrationsRequest")
+	proto.RegisterType((*ListRegistrationsResponse)(nil), "v1.ListRegistrationsResponse")
+	proto.RegisterType((*FeedToken)(nil), "v1.FeedToken")
+	proto.RegisterType((*APIKey)(nil), "v1.APIKey")
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "v1.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "v1.CreateAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeysResponse)(nil), "v1.ListAPIKeysResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "v1.RevokeAPIKeyRequest")
+}
+
+func init() {
+	proto.RegisterFile("v1/service.proto", fileDescriptor_d3e34d69331f2f1a)
+}
+
+var fileDescriptor_d3e34d69331f2f1a = []byte{
+	// 6020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x6b, 0x8c, 0x1c, 0xc9,
+	0x59, 0xd7, 0xbd, 0xaf, 0xd9, 0x5a, 0xdb, 0xbb, 0x2e, 0xdb, 0xeb, 0xf1, 0xd8, 0x3e, 0x57, 0xfa,
+	0x1e, 0xb6, 0x37, 0xbb, 0xb3, 0xeb, 0xf1, 0xe3, 0xee, 0xf6, 0x72, 0xb9, 0xf4, 0xcc, 0xce, 0xda,
+	0x63, 0xfb, 0xd6, 0x4e, 0xef, 0xfa, 0x2e, 0xe7, 0x70, 0xb7, 0xf4, 0x4e, 0xd7, 0xce, 0xd4, 0xb9,
+	0xa7, 0x7b, 0xae, 0xaa, 0x66, 0xd7, 0x6b, 0xe7, 0x50, 0x74, 0x51, 0xd0, 0x89, 0x80, 0xa2, 0x0c,
+	0xe8, 0x82, 0x50, 0x04, 0x04, 0x21, 0x44, 0x10, 0x12, 0x11, 0xd1, 0x85, 0xfc, 0x88, 0x00, 0x05,
+	0x09, 0x2e, 0x3f, 0x90, 0x0e, 0x25, 0x08, 0x14, 0xa1, 0x20, 0x50, 0x08, 0x11, 0xe2, 0x07, 0xa0,
+	0x88, 0x1f, 0x16, 0x08, 0x54, 0x8f, 0x7e, 0xcd, 0xcc, 0xfa, 0x01, 0x91, 0xe0, 0xd7, 0x6e, 0xd7,
+	0xf7, 0x55, 0xd5, 0xf7, 0xae, 0xaf, 0xbe, 0xaf, 0x06, 0x4c, 0x6d, 0x9d, 0x99, 0x67, 0x98, 0x6e,
+	0x91, 0x3a, 0x2e, 0xb6, 0x69, 0xc8, 0x43, 0x68, 0x6e, 0x9d, 0x29, 0x1c, 0x6b, 0x84, 0x61, 0xc3,
+	0xc7, 0xf3, 0x6e, 0x9b, 0xcc, 0xbb, 0x41, 0x10, 0x72, 0x97, 0x93, 0x30, 0x60, 0x0a, 0xa3, 0xf0,
+	0xb8, 0x86, 0xca, 0xaf, 0x8d, 0xce, 0xe6, 0xbc, 0xd7, 0xa1, 0x12, 0x41, 0xc3, 0x8f, 0xf6, 0xc2,
+	0x71, 0xab, 0xcd, 0x77, 0x34, 0xf0, 0x44, 0x2f, 0x90, 0x93, 0x16, 0x66, 0xdc, 0x6d, 0xb5, 0x35,
+	0xc2, 0xa1, 0xb0, 0x2d, 0x37, 0x9b, 0xd7, 0x7f, 0xf5, 0xf0, 0xac, 0xfc, 0x53, 0x9f, 0x6b, 0xe0,
+	0x60, 0x8e, 0x6d, 0xbb, 0x8d, 0x06, 0xa6, 0x11, 0xc6, 0x00, 0x12, 0x0f, 0x6f, 0xb9, 0x3e, 0xf1,
+	0x5c, 0x8e, 0xe7, 0xa3, 0x7f, 0x14, 0xc0, 0xfa, 0xba, 0x09, 0xc6, 0x5e, 0xc6, 0x94, 0x91, 0x30,
+	0x80, 0xcf, 0x83, 0xb1, 0x2d, 0xf5, 0x6f, 0xde, 0x40, 0xc6, 0xa9, 0xf1, 0xf2, 0x87, 0xba, 0xf6,
+	0xe3, 0xa5, 0x63, 0x6b, 0x4d, 0x8c, 0x36, 0x3a, 0xc4, 0xf7, 0x90, 0x86, 0xa2, 0x70, 0x13, 0xf1,
+	0x26, 0x46, 0xf6, 0xf5, 0x9a, 0x13, 0xcd, 0x80, 0xcf, 0x82, 0xd1, 0x0d, 0xea, 0x06, 0xf5, 0x66,
+	0xde, 0x94, 0x73, 0x51, 0xd7, 0x3e, 0x5e, 0x3a, 0x9a, 0xcc, 0x55, 0xc0, 0xf4, 0x54, 0x8d, 0x0f,
+	0x3f, 0x0a, 0x72, 0x14, 0x6f, 0x11, 0xb9, 0xef, 0x90, 0x9c, 0x6b, 0x75, 0xed, 0x13, 0xa5, 0xe3,
+	0xc9, 0xdc, 0x08, 0x9c, 0x9e, 0x1d, 0xcf, 0x59, 0xe4, 0x5d, 0xfb, 0x4d, 0x30, 0x33, 0x33, 0x61,
+	0x5f, 0xaf, 0x45, 0x14, 0xaa, 0x8d, 0x53, 0x03, 0x88, 0x04, 0x9b, 0x21, 0x6d, 0x49, 0x99, 0x94,
+	0x2a, 0xd0, 0xbe, 0x8b, 0x2c, 0x0d, 0xb1, 0x16, 0x91, 0xb5, 0x50, 0x5c, 0x28, 0x9e, 0xb1, 0x66,
+	0x91, 0xa5, 0x28, 0x12, 0x43, 0x2d, 0x97, 0x71, 0x4c, 0xc5, 0x58, 0xb4, 0x8f, 0x44, 0xac, 0x9f,
+	0xf5, 0x36, 0xcf, 0x5f, 0xb0, 0xd0, 0x5b, 0xd6, 0x6f, 0x4d, 0x83, 0x91, 0xea, 0x16, 0x0e, 0x38,
+	0x7c, 0x06, 0x98, 0xc4, 0xd3, 0x12, 0x3b, 0xd9, 0xb5, 0x9f, 0x2c, 0x59, 0x62, 0xf3, 0x4e, 0x40,
+	0xde, 0xec, 0x60, 0x44, 0x3c, 0x1c, 0x70, 0xb2, 0x49, 0x30, 0x8d, 0x88, 0xc7, 0x62, 0x92, 0x63,
+	0x12, 0x0f, 0x3e, 0x0f, 0x46, 0x78, 0xd8, 0x26, 0x75, 0x2d, 0xb1, 0xa7, 0xba, 0x76, 0xbe, 0x34,
+	0x2d, 0xe6, 0xca, 0xd1, 0x0c, 0xfe, 0xbd, 0xf2, 0x18, 0x1d, 0x99, 0x32, 0xf2, 0xef, 0x1b, 0x8e,
+	0x9a, 0x03, 0xaf, 0x80, 0x09, 0x0f, 0xb3, 0x3a, 0x25, 0x6d, 0x9e, 0x08, 0xee, 0x74, 0xac, 0xb0,
+	0x14, 0xac, 0x67, 0xa1, 0x11, 0x3a, 0x94, 0x7f, 0xe7, 0xa4, 0x93, 0x9e, 0x0d, 0x2f, 0x83, 0xe1,
+	0x66, 0xc8, 0x78, 0x7e, 0x58, 0xae, 0x72, 0xa1, 0x6b, 0x7f, 0xb8, 0x74, 0x5a, 0xac, 0x12, 0xb8,
+	0x2d, 0x1c, 0x4d, 0x17, 0x08, 0xa8, 0x4d, 0x31, 0x13, 0x0c, 0x05, 0x8d, 0xde, 0x25, 0xdf, 0x37,
+	0x1c, 0xb9, 0x06, 0xfc, 0xaa, 0x01, 0xc6, 0xef, 0x84, 0x61, 0x6b, 0xdd, 0x27, 0xc1, 0xad, 0xfc,
+	0x88, 0x5c, 0xb1, 0x6b, 0x74, 0xed, 0x37, 0x4b, 0xa1, 0x58, 0xf2, 0x66, 0x18, 0xb6, 0x90, 0x00,
+	0x21, 0x1e, 0xa2, 0x37, 0x42, 0x12, 0x24, 0x0b, 0x15, 0xd1, 0xb5, 0xc0, 0xdf, 0x41, 0x14, 0xf3,
+	0x0e, 0x0d, 0xb0, 0x27, 0x10, 0x04, 0x2c, 0xdc, 0x0e, 0x30, 0x45, 0x6e, 0x20, 0x07, 0xea, 0x61,
+	0xb0, 0x49, 0x68, 0x0b, 0x7b, 0xc8, 0xe5, 0x1c, 0x07, 0x1e, 0xc6, 0x0c, 0xb1, 0x66, 0x48, 0xb9,
+	0xbf, 0x83, 0x36, 0xf0, 0x66, 0x48, 0x71, 0x9a, 0xb0, 0xa3, 0xf4, 0x48, 0xfe, 0x83, 0xc9, 0xd2,
+	0xc1, 0xd7, 0x4f, 0x35, 0x39, 0x6f, 0xb3, 0x17, 0x17, 0xe7, 0xe7, 0x3f, 0xf9, 0xfa, 0x4f, 0xb1,
+	0xd7, 0x3e, 0x7c, 0xfa, 0xc5, 0x27, 0x9d, 0x9c, 0xa0, 0xf2, 0x2a, 0x09, 0x6e, 0xc1, 0x9b, 0x60,
+	0x84, 0x71, 0x97, 0xf2, 0xfc, 0x28, 0x32, 0x4e, 0x4d, 0x94, 0x0a, 0x45, 0xe5, 0x93, 0xc5, 0xc8,
+	0x27, 0x8b, 0x6b, 0x91, 0x4f, 0x96, 0x4f, 0xc5, 0x66, 0x2d, 0x67, 0x20, 0x4e, 0x12, 0x09, 0x45,
+	0xd2, 0xf8, 0x7d, 0xc3, 0xcc, 0x19, 0x8e, 0x5a, 0x12, 0x7e, 0x0a, 0x0c, 0xe1, 0xc0, 0xcb, 0x8f,
+	0x3d, 0x70, 0xe5, 0x95, 0xae, 0x7d, 0xa5, 0x54, 0x13, 0x2b, 0xe3, 0xc0, 0xeb, 0x5f, 0xb7, 0x88,
+	0x6a, 0x9b, 0x28, 0x6c, 0x11, 0xce, 0xb1, 0x37, 0x8b, 0x08, 0x47, 0x84, 0xa1, 0xba, 0xeb, 0xd7,
+	0x3b, 0xbe, 0xcb, 0xb1, 0x87, 0x36, 0x69, 0xd8, 0x92, 0xc8, 0x51, 0xec, 0x71, 0xc4, 0xb6, 0xf0,
+	0x2e, 0xc8, 0x45, 0x03, 0xf9, 0x9c, 0x24, 0xe1, 0x48, 0x1f, 0x09, 0x4b, 0x1a, 0xa1, 0xbc, 0xd4,
+	0xb5, 0xed, 0xd2, 0x8b, 0x6b, 0xa9, 0x45, 0x7a, 0x28, 0x90, 0xea, 0xe9, 0x30, 0xec, 0x21, 0xb2,
+	0x89, 0x82, 0x30, 0x21, 0x94, 0x30, 0xd4, 0xa6, 0xe1, 0x16, 0xf1, 0xb0, 0xe7, 0xc4, 0x1b, 0xc2,
+	0x3b, 0x60, 0x5c, 0x40, 0xd7, 0xef, 0x84, 0x01, 0xce, 0x8f, 0x4b, 0x43, 0x78, 0xad, 0x6b, 0xaf,
+	0x96, 0x3e, 0x2e, 0xb6, 0xa8, 0xd9, 0x2b, 0xb6, 0x9a, 0x2c, 0xc0, 0xc9, 0x2e, 0x6a, 0x2d, 0x69,
+	0x65, 0x62, 0x9f, 0x60, 0x16, 0xe1, 0x62, 0xa3, 0x88, 0xaa, 0x1d, 0x1a, 0xb6, 0xf1, 0x7c, 0x19,
+	0x53, 0x9f, 0x04, 0x45, 0xb4, 0x84, 0x37, 0xdd, 0x8e, 0xcf, 0x99, 0x30, 0x89, 0x1b, 0x6b, 0x95,
+	0x7b, 0xe5, 0x61, 0x6a, 0xe6, 0x3f, 0xe6, 0xe4, 0xc4, 0x82, 0x37, 0xc3, 0x00, 0xc3, 0x2f, 0x1a,
+	0x20, 0xe7, 0xbb, 0x41, 0xa3, 0xe3, 0x36, 0x70, 0x1e, 0xc8, 0xbd, 0xef, 0xc6, 0x02, 0x8e, 0x00,
+	0x11, 0x7b, 0x7a, 0x3f, 0xc5, 0xb2, 0xcb, 0x50, 0xb9, 0x72, 0x1d, 0x9d, 0x7b, 0x26, 0x41, 0xe3,
+	0x6e, 0x43, 0x93, 0x81, 0x03, 0x14, 0x52, 0xe4, 0xe1, 0xb9, 0xca, 0xa5, 0x7b, 0xe5, 0x19, 0x7a,
+	0xaa, 0xf4, 0xf4, 0xeb, 0xa7, 0x3e, 0xe9, 0xce, 0xdd, 0xb1, 0xe7, 0x6e, 0xbe, 0x76, 0xb7, 0x34,
+	0x7b, 0xf6, 0xad, 0x53, 0x73, 0xfa, 0x73, 0x61, 0xee, 0x39, 0x31, 0xf2, 0xec, 0x5b, 0xa7, 0x67,
+	0xa4, 0xb1, 0x45, 0x8b, 0xc1, 0x75, 0x90, 0xab, 0xbb, 0x6d, 0xb7, 0x4e, 0xf8, 0x4e, 0x7e, 0x02,
+	0x19, 0xa7, 0xf6, 0x96, 0x2b, 0x5d, 0xfb, 0x99, 0xd2, 0x79, 0x41, 0x58, 0xcb, 0xbd, 0x4d, 0x5a,
+	0x9d, 0x16, 0x0a, 0x3a, 0xad, 0x0d, 0x15, 0x31, 0x62, 0x2b, 0x2f, 0xa2, 0x9b, 0x98, 0x86, 0xa8,
+	0x85, 0xdd, 0x80, 0xa1, 0x4e, 0xe0, 0x93, 0x16, 0xe1, 0xd8, 0xbb, 0x57, 0x1e, 0x9d, 0x19, 0xce,
+	0xff, 0xfa, 0x2f, 0x8c, 0x3a, 0xf1, 0xa2, 0xf0, 0x0f, 0x0d, 0x30, 0xca, 0xb8, 0xcb, 0x3b, 0x2c,
+	0xbf, 0x07, 0x19, 0xa7, 0xf6, 0x95, 0x26, 0x8b, 0x5b, 0x67, 0x8a, 0x32, 0x56, 0xad, 0xca, 0xe1,
+	0xf2, 0x2f, 0x19, 0x5d, 0xfb, 0x1d, 0xa3, 0xf4, 0x59, 0x43, 0xdb, 0x31, 0xef, 0xb0, 0x1e, 0x4d,
+	0xa7, 0xe5, 0xcb, 0xea, 0x4d, 0xec, 0x75, 0x7c, 0xec, 0x15, 0x91, 0x5c, 0x84, 0x21, 0xd6, 0xd9,
+	0x50, 0x86, 0x88, 0x36, 0xa4, 0x3d, 0x50, 0x86, 0xb6, 0x9b, 0x21, 0x72, 0x29, 0x46, 0x41, 0xc8,
+	0x91, 0xeb, 0xb5, 0x48, 0xc0, 0xe4, 0x67, 0x1b, 0x07, 0x9e, 0x08, 0x16, 0x9d, 0x80, 0x13, 0x1f,
+	0xb9, 0x81, 0x82, 0x21, 0xb7, 0x2d, 0xcc, 0x05, 0x33, 0xb1, 0x65, 0xeb, 0x5e, 0x79, 0xe4, 0x6d,
+	0xc3, 0x9c, 0x32, 0x1c, 0x4d, 0x35, 0xfc, 0x24, 0xc8, 0x49, 0x9f, 0x5f, 0x27, 0x5e, 0x7e, 0xaf,
+	0x54, 0xdd, 0xc7, 0xba, 0xf6, 0x0b, 0xa5, 0xe7, 0xa5, 0xd9, 0x2c, 0x45, 0x94, 0x8a, 0x7d, 0xe5,
+	0xb6, 0x75, 0x8a, 0xa5, 0x0b, 0xa4, 0xc8, 0x5f, 0xc5, 0x5c, 0x90, 0x26, 0x46, 0xc4, 0x61, 0x8e,
+	0xa9, 0x33, 0x26, 0x57, 0xac, 0x79, 0xf0, 0xd3, 0x26, 0x00, 0x14, 0xd7, 0x3b, 0x94, 0xe2, 0xa0,
+	0x8e, 0xf3, 0xfb, 0xe4, 0xfa, 0x7f, 0x6b, 0x74, 0xed, 0xef, 0x18, 0xa5, 0x0f, 0xa4, 0x40, 0x12,
+	0x28, 0xa2, 0x1d, 0x3f, 0x36, 0x12, 0x86, 0x29, 0xc1, 0x4c, 0x98, 0x87, 0x87, 0x37, 0x49, 0x20,
+	0x8d, 0x13, 0x39, 0xcb, 0x15, 0x74, 0xfe, 0xfc, 0xb9, 0xf3, 0xda, 0x3c, 0x96, 0x9d, 0xea, 0xc7,
+	0x5f, 0x78, 0xa5, 0x5a, 0xbd, 0x72, 0xf5, 0xd5, 0xe7, 0xcb, 0xaf, 0x2e, 0xd9, 0xaf, 0xbe, 0xf0,
+	0x4a, 0xb5, 0x88, 0x2a, 0x82, 0x40, 0x21, 0x05, 0x37, 0xd0, 0x36, 0xbe, 0x4d, 0x78, 0x13, 0xb9,
+	0x7d, 0x3b, 0x29, 0x4e, 0x18, 0x72, 0xf5, 0x66, 0x45, 0xb4, 0xda, 0x69, 0xb7, 0x43, 0x2a, 0xb8,
+	0x13, 0xd2, 0x14, 0xcb, 0xcf, 0xa2, 0xda, 0xca, 0x5a, 0xd5, 0x79, 0xd9, 0xbe, 0x3a, 0x8b, 0x2a,
+	0xd7, 0x6e, 0xac, 0xac, 0xcd, 0xa2, 0x1b, 0x2b, 0x6b, 0xb5, 0xab, 0xb3, 0x48, 0x6e, 0x28, 0xe3,
+	0x64, 0xf9, 0xd5, 0x97, 0xae, 0xad, 0xac, 0x5d, 0x5a, 0xb2, 0x5f, 0x55, 0xb1, 0xf9, 0xc7, 0x43,
+	0x4e, 0x8a, 0x67, 0xc8, 0xc0, 0xb8, 0x5a, 0x5f, 0x08, 0x78, 0x52, 0x0a, 0xe0, 0xe5, 0xc4, 0x2f,
+	0x97, 0x7a, 0x18, 0x26, 0x9b, 0x59, 0xe7, 0x74, 0x03, 0x14, 0xd6, 0x63, 0xba, 0x85, 0x89, 0x26,
+	0xd4, 0xf6, 0x89, 0x3d, 0xa7, 0x40, 0x35, 0x0f, 0xde, 0x01, 0xc3, 0xdc, 0x6d, 0xb0, 0xfc, 0x14,
+	0x1a, 0x3a, 0x35, 0x5e, 0xde, 0x8c, 0xf7, 0xdb, 0xa4, 0x58, 0x3a, 0x58, 0xd6, 0x02, 0xb5, 0x3c,
+	0xeb, 0x21, 0x0d, 0x03, 0x57, 0xb8, 0xdc, 0x26, 0x09, 0x38, 0xae, 0x37, 0x8b, 0x68, 0x4d, 0xa0,
+	0x0a, 0x61, 0x30, 0x1e, 0x52, 0xa5, 0x04, 0x3f, 0xdc, 0xc6, 0x14, 0xd5, 0x5d, 0x86, 0xef, 0x95,
+	0xf7, 0x76, 0x0d, 0x30, 0x05, 0xac, 0x51, 0x3a, 0x3c, 0x65, 0xe4, 0x4b, 0x8e, 0xdc, 0x13, 0xde,
+	0x02, 0x13, 0x75, 0x97, 0xe3, 0x46, 0x48, 0x77, 0x04, 0xcb, 0xfb, 0x25, 0xcb, 0x97, 0xbb, 0xf6,
+	0xc5, 0x52, 0x35, 0xcb, 0x72, 0x84, 0xd5, 0xe3, 0x0d, 0x15, 0x35, 0x2c, 0xd5, 0x4f, 0x31, 0xaa,
+	0x8b, 0x00, 0xa7, 0x8c, 0x5f, 0x99, 0xba, 0x03, 0xa2, 0x89, 0x35, 0x0f, 0xbe, 0x6b, 0x80, 0x31,
+	0x71, 0x10, 0x8a, 0x9d, 0xa0, 0xdc, 0xe9, 0xad, 0xae, 0x7d, 0xa7, 0x74, 0x3b, 0xbb, 0x53, 0x9b,
+	0x86, 0x9b, 0xc4, 0x7f, 0xf0, 0xe1, 0x2a, 0xc3, 0x3e, 0xc3, 0x5c, 0xea, 0x55, 0x62, 0x11, 0x86,
+	0x64, 0xfe, 0x38, 0x1b, 0x7f, 0x0a, 0xb0, 0x3e, 0x2b, 0xd3, 0x07, 0xb6, 0xde, 0xc2, 0x19, 0x15,
+	0x78, 0x35, 0x0f, 0xba, 0x60, 0xa2, 0x1e, 0xae, 0x6b, 0xd2, 0x58, 0xfe, 0x80, 0x54, 0x84, 0xdd,
+	0xb5, 0x2f, 0x94, 0xce, 0x29, 0xda, 0x58, 0xcf, 0xcc, 0xf8, 0xbb, 0x1e, 0xce, 0x89, 0x69, 0xac,
+	0xf7, 0xa0, 0xeb, 0x1a, 0xe6, 0xd4, 0x88, 0x33, 0x5e, 0x0f, 0x2f, 0xc9, 0x1d, 0x18, 0x64, 0x60,
+	0x8a, 0xe2, 0x37, 0x70, 0x5d, 0x04, 0xd2, 0x75, 0x8a, 0x5d, 0x16, 0x06, 0xf9, 0x83, 0x52, 0x06,
+	0x97, 0xba, 0x76, 0xb5, 0x54, 0x51, 0xee, 0x25, 0x86, 0x93, 0x58, 0xd0, 0x70, 0xb7, 0x30, 0xda,
+	0x0c, 0x29, 0xd2, 0x33, 0xb3, 0xdc, 0xf7, 0x9b, 0xd4, 0x64, 0xbc, 0x83, 0x23, 0x57, 0x5a, 0xfc,
+	0xd2, 0x50, 0xd7, 0xfe, 0xe5, 0x21, 0x70, 0x7a, 0x46, 0xe5, 0x63, 0x25, 0x64, 0x47, 0x6e, 0x26,
+	0x88, 0x56, 0x26, 0xe2, 0x22, 0x9f, 0x6c, 0x89, 0xa5, 0x69, 0x18, 0xb6, 0x4a, 0xff, 0x64, 0xc2,
+	0x7f, 0x34, 0xef, 0x22, 0x8b, 0x78, 0x22, 0xa9, 0x13, 0x49, 0x9e, 0xcc, 0xa9, 0xc4, 0xc7, 0xa5,
+	0x70, 0x1b, 0x6d, 0x11, 0xda, 0x61, 0x98, 0x21, 0xd6, 0xa6, 0xd8, 0xf5, 0x04, 0x38, 0x95, 0x2b,
+	0x09, 0x24, 0xb1, 0x41, 0x9b, 0x78, 0xb8, 0x45, 0x42, 0x3f, 0x6c, 0x10, 0xc6, 0x11, 0x77, 0xfd,
+	0x5b, 0x0c, 0xb9, 0x1b, 0x61, 0x47, 0xec, 0x3a, 0x68, 0x09, 0x41, 0x8b, 0x98, 0x7b, 0xd9, 0x0d,
+	0x30, 0x5a, 0x0a, 0xb1, 0x18, 0x8b, 0xd3, 0x24, 0x01, 0x90, 0x19, 0xca, 0xe2, 0xfc, 0xbc, 0x18,
+	0x2c, 0x76, 0xd8, 0xfc, 0x1b, 0xf3, 0x67, 0x4a, 0x67, 0xcf, 0x9d, 0xbf, 0xf0, 0xcc, 0xb3, 0xcf,
+	0x09, 0x5c, 0x99, 0x4d, 0x08, 0xbc, 0xd2, 0x42, 0x69, 0x61, 0x6e, 0xe1, 0xdc, 0xdc, 0xc2, 0x99,
+	0xb5, 0x33, 0xcf, 0x2e, 0x2e, 0x2c, 0x2c, 0x2e, 0x2c, 0xdc, 0x14, 0x08, 0x38, 0xf0, 0x7a, 0xc1,
+	0xcf, 0xa5, 0xc0, 0xd1, 0xa9, 0x2c, 0x70, 0xce, 0x5e, 0x58, 0x58, 0x60, 0x92, 0xed, 0xe8, 0x78,
+	0x16, 0xa3, 0x99, 0x23, 0x56, 0x40, 0xa3, 0x23, 0x4b, 0x00, 0xb1, 0x1c, 0x89, 0xce, 0x18, 0x6b,
+	0x11, 0x95, 0xce, 0x2b, 0xa2, 0x78, 0x87, 0xc9, 0xc9, 0x2f, 0x57, 0x57, 0xd6, 0xd6, 0x57, 0xd7,
+	0xec, 0xb5, 0x1b, 0xab, 0xeb, 0xab, 0x95, 0x4b, 0xd5, 0xa5, 0x1b, 0x57, 0xab, 0x4b, 0x22, 0x51,
+	0x7e, 0x03, 0x40, 0x19, 0x00, 0xb1, 0xd4, 0x8e, 0x83, 0xdf, 0xec, 0x60, 0xc6, 0xe1, 0x69, 0x30,
+	0x22, 0x75, 0x24, 0xf3, 0xe6, 0x89, 0xd2, 0x78, 0x7c, 0x44, 0x95, 0x73, 0xf7, 0xca, 0x23, 0x3f,
+	0x27, 0xcf, 0x03, 0x85, 0x01, 0x4f, 0x83, 0x29, 0xd2, 0x08, 0x42, 0x8a, 0xd7, 0x45, 0xf2, 0xe7,
+	0x93, 0x3a, 0x67, 0x32, 0x63, 0xce, 0x39, 0x93, 0x6a, 0xbc, 0x12, 0x0d, 0x5b, 0x33, 0x60, 0xf2,
+	0x22, 0xe6, 0x99, 0x8d, 0x0e, 0xa7, 0xb2, 0xf3, 0x31, 0x99, 0x27, 0x4c, 0x19, 0x22, 0xfb, 0xb6,
+	0xde, 0x1e, 0x02, 0xfb, 0xaf, 0x12, 0xa6, 0xb0, 0x59, 0x84, 0x5e, 0x04, 0xc3, 0x22, 0x95, 0xca,
+	0x1b, 0x0f, 0xca, 0xd7, 0x1c, 0x89, 0x07, 0x67, 0x80, 0xc9, 0xc3, 0xbc, 0xf9, 0x40, 0x6c, 0x93,
+	0x87, 0xf0, 0x24, 0x18, 0x6f, 0xbb, 0x0d, 0xbc, 0xce, 0xc8, 0x1d, 0x2c, 0x13, 0xf6, 0x91, 0x32,
+	0xb8, 0x57, 0x1e, 0x2b, 0x8c, 0xe4, 0x7f, 0x3c, 0x74, 0xea, 0x31, 0x27, 0x27, 0x80, 0xab, 0xe4,
+	0x0e, 0x86, 0xc7, 0x01, 0x90, 0x88, 0x3c, 0xbc, 0x85, 0x03, 0x95, 0x94, 0x3b, 0x72, 0xea, 0x9a,
+	0x18, 0x80, 0x50, 0x67, 0xeb, 0x32, 0xb7, 0xd6, 0x59, 0xf7, 0x99, 0xf8, 0xcc, 0x1f, 0x1d, 0x7c,
+	0xe6, 0xe7, 0xfa, 0x8e, 0xd9, 0x42, 0x2a, 0x43, 0x1a, 0x93, 0x4b, 0xc5, 0xdf, 0xf0, 0x2c, 0xc8,
+	0x85, 0xd4, 0xc3, 0x74, 0x7d, 0x63, 0x47, 0xe6, 0x8d, 0xfb, 0x4a, 0xfb, 0xe2, 0x05, 0xaf, 0x09,
+	0x40, 0x6a, 0xbd, 0x31, 0x89, 0x59, 0xde, 0x81, 0x53, 0x60, 0x88, 0xbb, 0x0d, 0x95, 0xe9, 0x39,
+	0xe2, 0x5f, 0x78, 0x22, 0x1b, 0x78, 0x65, 0x1e, 0x96, 0x0e, 0x96, 0xd6, 0x3a, 0x80, 0x69, 0x1d,
+	0xb0, 0x76, 0x18, 0x30, 0x0c, 0x3f, 0x04, 0x46, 0xa5, 0xea, 0x59, 0xde, 0x40, 0x43, 0x19, 0xeb,
+	0x70, 0x34, 0x00, 0x3e, 0x0d, 0x26, 0x03, 0x7c, 0x9b, 0xaf, 0xa7, 0xe4, 0x24, 0x6f, 0x51, 0xce,
+	0x5e, 0x31, 0x7c, 0x3d, 0x92, 0x95, 0xf5, 0x0d, 0x03, 0xc0, 0x1b, 0x6d, 0xaf, 0xd7, 0xfc, 0x76,
+	0xb3, 0x8a, 0xc4, 0x2e, 0xcd, 0x07, 0xda, 0xe5, 0x59, 0x30, 0xc2, 0xea, 0x61, 0x5b, 0xa9, 0x72,
+	0x5f, 0xe9, 0x80, 0x40, 0x75, 0xe2, 0x53, 0x76, 0x55, 0x80, 0x52, 0x52, 0x52, 0xb8, 0x03, 0x8d,
+	0x79, 0x78, 0xb0, 0x31, 0x6f, 0x00, 0xb8, 0x84, 0x7d, 0xfc, 0xb0, 0x94, 0xc7, 0xe4, 0x98, 0x0f,
+	0x4f, 0x8e, 0xb5, 0x0e, 0x0e, 0xac, 0x62, 0x97, 0xd6, 0x9b, 0x59, 0x2f, 0x40, 0x60, 0xe4, 0xcd,
+	0x0e, 0xa6, 0x3b, 0x7a, 0x1f, 0x90, 0xbe, 0x7e, 0x4a, 0x00, 0x7c, 0x3a, 0x6d, 0xcb, 0xa6, 0xb4,
+	0xe5, 0xf1, 0x7b, 0xe5, 0xd1, 0xc2, 0x70, 0xde, 0x4b, 0x9b, 0xb2, 0xc5, 0xc1, 0x1e, 0xb5, 0x81,
+	0x83, 0x59, 0xc7, 0xe7, 0xf0, 0xc4, 0x6e, 0x7e, 0x1f, 0x49, 0xf5, 0xa0, 0x64, 0x83, 0xaa, 0x45,
+	0x15, 0x9d, 0x14, 0xc3, 0x39, 0x00, 0x9a, 0xa4, 0xd1, 0xf4, 0x49, 0xa3, 0xc9, 0x59, 0x7e, 0x48,
+	0x5a, 0xc5, 0x5e, 0x31, 0xf7, 0x52, 0x34, 0xea, 0xa4, 0x10, 0xac, 0xe7, 0xc1, 0x78, 0x0c, 0x10,
+	0x2b, 0x6e, 0x12, 0xec, 0x6b, 0xa1, 0x39, 0xea, 0x03, 0xe6, 0xc1, 0x18, 0x0b, 0x48, 0xbb, 0x8d,
+	0xb9, 0x36, 0x9c, 0xe8, 0xd3, 0x2a, 0x83, 0x83, 0x59, 0x99, 0x68, 0xab, 0x9c, 0x01, 0x63, 0x54,
+	0x32, 0x11, 0x99, 0xe5, 0x94, 0x20, 0x20, 0xcd, 0x9d, 0x13, 0x21, 0x58, 0x18, 0xe4, 0x74, 0xd2,
+	0xb0, 0x03, 0xf7, 0x25, 0x1a, 0x93, 0x8a, 0x3a, 0x0e, 0x86, 0xc5, 0x31, 0xad, 0x6f, 0xfd, 0x42,
+	0x6a, 0x32, 0x59, 0xf1, 0x1c, 0x39, 0x0c, 0x67, 0x06, 0x5d, 0xec, 0x73, 0x2a, 0x91, 0xfb, 0xd1,
+	0x58, 0xe6, 0xde, 0x6e, 0x5d, 0x01, 0x87, 0x54, 0x6c, 0x8d, 0x36, 0x8b, 0x14, 0x58, 0x12, 0x97,
+	0x0c, 0x35, 0xa4, 0x25, 0xbd, 0x47, 0x10, 0x1b, 0xa1, 0xa5, 0x8c, 0x39, 0xc6, 0xb3, 0x3c, 0x70,
+	0x48, 0x79, 0x4a, 0xef, 0x62, 0xbb, 0x9a, 0x5c, 0x7a, 0x17, 0xf3, 0x21, 0x77, 0x59, 0x00, 0x87,
+	0x94, 0x55, 0x3f, 0xec, 0x2e, 0xd6, 0x32, 0x98, 0x16, 0x31, 0x22, 0x49, 0xc2, 0x62, 0x8d, 0xcc,
+	0x82, 0x28, 0x96, 0x10, 0x1c, 0x29, 0x25, 0x43, 0x81, 0x93, 0x82, 0x5b, 0x17, 0x00, 0xbc, 0x88,
+	0xf9, 0x9a, 0xdb, 0xa8, 0xf8, 0x61, 0xc7, 0x4b, 0x99, 0xba, 0xbc, 0x4b, 0xe5, 0x8d, 0x74, 0x40,
+	0x7e, 0xdf, 0x38, 0xf5, 0x98, 0xa3, 0x00, 0x56, 0x09, 0xe4, 0xc4, 0xa4, 0xb0, 0x13, 0xf0, 0x28,
+	0xc4, 0x19, 0x49, 0x88, 0x3b, 0x08, 0x46, 0xea, 0x02, 0xa4, 0x9c, 0xc0, 0x51, 0x1f, 0xd6, 0x2c,
+	0xc8, 0x45, 0x1b, 0x41, 0xa4, 0x33, 0xdf, 0x14, 0x7d, 0xd1, 0x7a, 0x2a, 0x3f, 0xb5, 0xbe, 0x60,
+	0x82, 0x61, 0x91, 0x42, 0xf5, 0x99, 0xca, 0x61, 0x30, 0x26, 0xae, 0x39, 0x22, 0x76, 0x2a, 0x23,
+	0x1d, 0x15, 0x9f, 0x35, 0x0f, 0x1e, 0xd3, 0x36, 0x94, 0xb1, 0x0e, 0x51, 0x82, 0x91, 0x26, 0x54,
+	0x00, 0x43, 0x1b, 0x24, 0xcc, 0x0f, 0xa7, 0x81, 0x1f, 0x4c, 0x3a, 0x62, 0x10, 0xbe, 0x00, 0x80,
+	0xbb, 0xe5, 0x72, 0x97, 0xae, 0x77, 0xa8, 0xaf, 0xcb, 0x33, 0x8f, 0x3f, 0xa0, 0x52, 0x32, 0xae,
+	0x66, 0xdc, 0xa0, 0x3e, 0x9c, 0x15, 0xe2, 0x0a, 0x6e, 0x89, 0x63, 0x26, 0xe6, 0x46, 0x90, 0x2e,
+	0xea, 0x28, 0xe5, 0x9c, 0xce, 0x09, 0x81, 0xa3, 0x90, 0xe0, 0x73, 0x00, 0x74, 0xa4, 0x49, 0x79,
+	0xeb, 0x2e, 0x7f, 0x70, 0x0d, 0xc4, 0x19, 0xd7, 0xd8, 0x36, 0xb7, 0x3e, 0x01, 0x72, 0xd1, 0xba,
+	0xf0, 0x38, 0x18, 0xe1, 0x84, 0xfb, 0x38, 0x63, 0x1d, 0x79, 0xcf, 0x51, 0xa3, 0x70, 0x0e, 0x0c,
+	0x09, 0x5e, 0x94, 0x3f, 0x1d, 0xbd, 0x57, 0xce, 0xd3, 0x69, 0xc1, 0xcb, 0xfe, 0xd7, 0x7b, 0x58,
+	0x79, 0xd2, 0x11, 0x78, 0xd6, 0x12, 0x28, 0x28, 0x3b, 0x7f, 0x69, 0x47, 0xec, 0x70, 0x5d, 0xa7,
+	0xc9, 0xda, 0x1e, 0x9e, 0xd6, 0x87, 0xab, 0xf2, 0x9a, 0x5c, 0xc4, 0x5f, 0xca, 0x96, 0x25, 0xdc,
+	0x3a, 0x0d, 0xf6, 0x5d, 0xc4, 0x5c, 0x80, 0x1e, 0x68, 0xc0, 0x9f, 0x00, 0x87, 0x84, 0x01, 0x0b,
+	0xdc, 0x6c, 0x98, 0xdd, 0xd5, 0xb1, 0x1e, 0x36, 0xba, 0x7e, 0x0a, 0x4c, 0xf7, 0xae, 0xac, 0x5d,
+	0xe3, 0xd8, 0x60, 0x36, 0x74, 0xb6, 0xf0, 0x14, 0xc8, 0x75, 0xda, 0xf5, 0xb0, 0x45, 0x82, 0x46,
+	0xde, 0xec, 0x3d, 0x62, 0x63, 0x90, 0x88, 0x54, 0x6d, 0x97, 0xf1, 0xfc, 0x50, 0x2f, 0x8a, 0x1c,
+	0xb6, 0x36, 0x40, 0x5e, 0xec, 0x7e, 0x5d, 0xdd, 0xf1, 0xb3, 0xac, 0x65, 0x72, 0x1d, 0xe3, 0xa1,
+	0x73, 0x1d, 0xb3, 0x27, 0xd7, 0xb1, 0x8a, 0xe0, 0x80, 0xad, 0x8a, 0x05, 0x0f, 0x97, 0xd5, 0x7d,
+	0x1c, 0x40, 0x47, 0xde, 0x0f, 0x1e, 0xee, 0xd0, 0xb4, 0xc0, 0xa8, 0xbe, 0xa6, 0x98, 0xd9, 0x93,
+	0xee, 0x47, 0x63, 0x8e, 0x86, 0x58, 0x73, 0x32, 0x6e, 0x5c, 0x0e, 0x49, 0x20, 0x8c, 0xf1, 0x81,
+	0x14, 0x94, 0x41, 0x2e, 0xc2, 0x85, 0x47, 0x40, 0x4e, 0x9e, 0x6a, 0xeb, 0xb1, 0x57, 0x8f, 0xc9,
+	0xef, 0x9a, 0x07, 0x8f, 0xa6, 0xab, 0xa4, 0x8a, 0xed, 0xb8, 0x20, 0x69, 0x7d, 0xcf, 0x00, 0x7b,
+	0x1c, 0x2c, 0x6e, 0x16, 0xba, 0x94, 0xd6, 0x1b, 0x18, 0xd2, 0x0b, 0x9b, 0xd9, 0x85, 0x53, 0x31,
+	0x63, 0x28, 0x13, 0x33, 0x8e, 0x82, 0x71, 0x09, 0x90, 0x81, 0x43, 0x25, 0x95, 0x39, 0x31, 0xb0,
+	0x22, 0x42, 0x46, 0x31, 0xce, 0x1f, 0x47, 0x64, 0xfa, 0x30, 0xad, 0xd2, 0x87, 0x84, 0x04, 0x95,
+	0x46, 0xc6, 0xc9, 0xe3, 0x73, 0x00, 0xe8, 0xba, 0x8b, 0xf0, 0xec, 0xd1, 0x07, 0x7b, 0xb6, 0xc6,
+	0xb6, 0xb9, 0xf5, 0x02, 0x38, 0xac, 0x16, 0xc6, 0x74, 0x39, 0xa4, 0x19, 0x3d, 0x59, 0xbd, 0xf2,
+	0x4a, 0x44, 0x1b, 0xf1, 0x67, 0xbd, 0x08, 0x8e, 0x54, 0xdc, 0xa0, 0x8e, 0xfd, 0x34, 0x75, 0x8f,
+	0xb2, 0xc0, 0x47, 0x95, 0xd9, 0xa6, 0xa7, 0xb3, 0x47, 0x99, 0xbf, 0x0a, 0x8e, 0x0c, 0x98, 0xaf,
+	0xfd, 0xee, 0x02, 0xd8, 0x4b, 0xd3, 0x80, 0x74, 0xaa, 0x90, 0x21, 0x38, 0x8b, 0x66, 0x9d, 0x07,
+	0xe3, 0xcb, 0x18, 0x7b, 0x2a, 0xc1, 0x3f, 0x08, 0x46, 0x94, 0x3b, 0xe8, 0x8c, 0x85, 0x47, 0x69,
+	0x7f, 0xdb, 0xe5, 0xba, 0xbf, 0xe2, 0xc8, 0xff, 0xad, 0xaf, 0x99, 0x60, 0xd4, 0xbe, 0x5e, 0xbb,
+	0x82, 0x1f, 0x39, 0xcd, 0x98, 0x06, 0xa3, 0x6d, 0x8a, 0x37, 0xc9, 0xed, 0xc8, 0x4a, 0xd4, 0x17,
+	0x2c, 0x82, 0x89, 0x36, 0xa6, 0x2d, 0xc2, 0x98, 0x24, 0x7f, 0x58, 0x56, 0x09, 0xf6, 0xdc, 0x2b,
+	0x8f, 0x77, 0x8d, 0xd1, 0x9c, 0x31, 0x75, 0x30, 0x6f, 0x38, 0x69, 0x84, 0x1e, 0x43, 0x18, 0x79,
+	0x04, 0x43, 0x10, 0x53, 0xf1, 0xed, 0x36, 0xa1, 0x98, 0x3d, 0xa4, 0x0d, 0x69, 0x6c, 0x9b, 0xc3,
+	0x8f, 0x80, 0x3d, 0xbe, 0xcb, 0xf8, 0xba, 0x28, 0x46, 0x3f, 0xdc, 0xd1, 0x02, 0x04, 0xfe, 0x0d,
+	0x26, 0x2d, 0x70, 0x09, 0x1c, 0x50, 0x69, 0x93, 0x12, 0x5d, 0xa4, 0xfc, 0x39, 0x30, 0xe6, 0xb6,
+	0xc9, 0xfa, 0x2d, 0x1c, 0xe5, 0x4c, 0x40, 0x68, 0x4d, 0xe1, 0xa4, 0xe2, 0xff, 0xa8, 0xdb, 0x26,
+	0x57, 0xf0, 0x8e, 0xf5, 0x12, 0x38, 0x98, 0x5d, 0x45, 0x9b, 0xc0, 0x13, 0xf7, 0x59, 0x26, 0x9a,
+	0x2c, 0x12, 0x09, 0x81, 0xa0, 0x74, 0x29, 0xfe, 0xb5, 0x3e, 0x02, 0x0e, 0x08, 0xb3, 0x52, 0x78,
+	0x89, 0x41, 0x3d, 0x05, 0x72, 0x7a, 0xb5, 0xc8, 0x96, 0xd2, 0xcb, 0x8d, 0xa9, 0xe5, 0x98, 0x88,
+	0x93, 0x0e, 0xde, 0x0a, 0x6f, 0xf5, 0xb0, 0xb4, 0x5b, 0x94, 0x9a, 0xf9, 0x7b, 0x03, 0x4c, 0xa4,
+	0xae, 0x87, 0xf0, 0x18, 0xc8, 0x67, 0xae, 0xf0, 0x37, 0x56, 0x56, 0xaf, 0x57, 0x2b, 0xb5, 0xe5,
+	0x5a, 0x75, 0x69, 0xea, 0x31, 0x38, 0x0d, 0x60, 0x06, 0xba, 0xe4, 0xd8, 0xcb, 0x6b, 0x53, 0x06,
+	0x2c, 0x80, 0xe9, 0xc1, 0x17, 0xff, 0x29, 0x13, 0x1e, 0x02, 0xfb, 0x33, 0xb0, 0xab, 0xb5, 0x97,
+	0xab, 0x53, 0x43, 0xf0, 0x08, 0x38, 0x94, 0x19, 0x5e, 0xae, 0xad, 0xd4, 0x56, 0x2f, 0x55, 0x97,
+	0xa6, 0x86, 0xfb, 0x56, 0xab, 0xd8, 0x2b, 0x95, 0xea, 0x55, 0xb1, 0xda, 0x08, 0xcc, 0x83, 0x83,
+	0x19, 0xd8, 0xf5, 0xea, 0xca, 0x52, 0x6d, 0xe5, 0xe2, 0xd4, 0x68, 0xdf, 0x82, 0x4e, 0xf5, 0x72,
+	0xb5, 0xb2, 0x56, 0x5d, 0x9a, 0x1a, 0x9b, 0xf1, 0x01, 0x48, 0x6e, 0xac, 0xf0, 0x28, 0x38, 0xac,
+	0x10, 0xaf, 0x39, 0x4b, 0x55, 0xa7, 0x87, 0xc3, 0x13, 0xe0, 0x68, 0x1a, 0xb8, 0xba, 0x66, 0x3b,
+	0x6b, 0xeb, 0xf6, 0x6a, 0x45, 0x6f, 0x63, 0x40, 0x04, 0x8e, 0xf5, 0x23, 0x2c, 0x55, 0x63, 0x0c,
+	0x73, 0xe6, 0x6d, 0x03, 0x4c, 0xf6, 0x5c, 0xb8, 0xc4, 0x2c, 0xa7, 0x5a, 0xb9, 0xe1, 0x38, 0xd5,
+	0x95, 0x4a, 0x75, 0x7d, 0xb5, 0x72, 0xed, 0x7a, 0xb5, 0x67, 0xe3, 0x27, 0x01, 0xea, 0xc3, 0x58,
+	0xbb, 0x54, 0x5b, 0x5d, 0xbf, 0x56, 0x89, 0x46, 0xa7, 0x0c, 0x78, 0x12, 0x3c, 0x31, 0x18, 0xcb,
+	0x5e, 0x59, 0x5a, 0x5f, 0xbe, 0x76, 0xf5, 0xea, 0xb5, 0x57, 0x14, 0x11, 0x9f, 0x36, 0xc4, 0x01,
+	0xd8, 0x1b, 0xb6, 0xe1, 0x13, 0xe0, 0x84, 0x53, 0xbd, 0x58, 0x5b, 0x5d, 0x73, 0xec, 0xb5, 0xda,
+	0xb5, 0x95, 0xc1, 0x5a, 0xfe, 0x10, 0x38, 0x3e, 0x08, 0xa9, 0x72, 0x6d, 0x65, 0xb9, 0xe6, 0xbc,
+	0x54, 0x5d, 0x9a, 0x32, 0xa0, 0x05, 0x1e, 0x1f, 0x84, 0xf2, 0x8a, 0x5d, 0x5b, 0xbb, 0x5a, 0x5b,
+	0x15, 0x52, 0x37, 0x4b, 0xff, 0xb9, 0x08, 0xa6, 0x2a, 0x61, 0xa7, 0xde, 0xac, 0x84, 0x41, 0xa0,
+	0x2a, 0x75, 0x0c, 0x7e, 0xc6, 0x00, 0xe0, 0x22, 0xe6, 0x51, 0xab, 0x79, 0xba, 0xcf, 0x53, 0xab,
+	0xa2, 0xa6, 0x59, 0x98, 0x10, 0xb6, 0xad, 0x91, 0xac, 0xeb, 0x5d, 0xfb, 0x05, 0x90, 0xab, 0x05,
+	0x1c, 0xd3, 0xc0, 0xf5, 0xa1, 0x6c, 0xf0, 0x6a, 0x58, 0xe1, 0x49, 0x47, 0x76, 0x09, 0x19, 0xe2,
+	0xbb, 0x37, 0x7a, 0x8b, 0x6f, 0x7f, 0xe7, 0x07, 0xbf, 0x68, 0x02, 0x98, 0x9b, 0xd7, 0x40, 0xf8,
+	0x95, 0x21, 0x30, 0x91, 0x2a, 0x46, 0x41, 0x79, 0xca, 0xf5, 0x57, 0xa7, 0x0a, 0x49, 0xaa, 0x63,
+	0xfd, 0xbb, 0xd9, 0xb5, 0xff, 0xd2, 0x04, 0xa3, 0x55, 0x55, 0x77, 0xd8, 0xa3, 0xb0, 0x55, 0x81,
+	0xb1, 0xf0, 0x4d, 0xb3, 0x12, 0x97, 0xec, 0x03, 0xbc, 0x1d, 0x15, 0x2d, 0x15, 0x6e, 0xdc, 0x98,
+	0xf8, 0x49, 0x34, 0x49, 0x64, 0x1d, 0x38, 0xa9, 0xc3, 0x37, 0x5d, 0xd6, 0xdf, 0x3f, 0x98, 0x8d,
+	0x4b, 0xf1, 0x88, 0x24, 0xbb, 0x8b, 0xc2, 0x31, 0xe1, 0x0c, 0x6d, 0x12, 0xca, 0x78, 0xba, 0x74,
+	0x4f, 0x58, 0xdc, 0x6a, 0x2d, 0xa2, 0x65, 0x97, 0xf8, 0x4c, 0xf5, 0x25, 0x96, 0xed, 0xda, 0xd5,
+	0xea, 0xd2, 0xfa, 0x75, 0xa7, 0x5a, 0xb9, 0xb6, 0xb2, 0x54, 0x13, 0x7a, 0xce, 0x36, 0x01, 0xc2,
+	0x2d, 0x4c, 0x7d, 0xb7, 0xad, 0xd1, 0xdd, 0x20, 0xe4, 0x4d, 0x4c, 0x23, 0x98, 0x42, 0x64, 0xa2,
+	0x0e, 0x2d, 0xeb, 0xd3, 0x21, 0x55, 0x68, 0xf1, 0xa8, 0xec, 0xf9, 0x8a, 0xac, 0xa7, 0xf8, 0xce,
+	0x7b, 0x79, 0x53, 0xaa, 0xe8, 0x80, 0x05, 0xe6, 0xb7, 0xce, 0xcc, 0xcb, 0x15, 0xd8, 0xa2, 0x2e,
+	0x04, 0x6c, 0x81, 0x5c, 0x54, 0xcb, 0x83, 0xb2, 0x98, 0xd1, 0x53, 0xd9, 0x4b, 0x2b, 0xe9, 0x72,
+	0xd7, 0x9e, 0x8d, 0x55, 0x34, 0x7e, 0x11, 0x73, 0xad, 0x9f, 0xc3, 0x91, 0x95, 0xb8, 0x88, 0x91,
+	0xa0, 0xe1, 0x47, 0x65, 0xe5, 0x77, 0xde, 0xcb, 0x1b, 0x72, 0xe7, 0xfd, 0x70, 0x32, 0xd9, 0x79,
+	0xfe, 0x2e, 0xf1, 0xde, 0x82, 0xdf, 0x35, 0x01, 0x48, 0x6a, 0x52, 0xf0, 0x90, 0xd8, 0xa5, 0xaf,
+	0x4e, 0x58, 0x98, 0xee, 0x1d, 0x56, 0xe1, 0xda, 0x7a, 0xd7, 0xec, 0xda, 0xff, 0x61, 0xc4, 0xb4,
+	0x4c, 0x08, 0x14, 0xb5, 0x29, 0x2b, 0xfc, 0xc0, 0x48, 0xc8, 0x69, 0xeb, 0xf6, 0xa3, 0x02, 0x21,
+	0xde, 0x74, 0x39, 0x6a, 0xb9, 0xbc, 0xae, 0x04, 0xb5, 0x49, 0x7c, 0x8e, 0xa9, 0xec, 0xa8, 0xc4,
+	0x5d, 0x07, 0x7c, 0xbb, 0xed, 0x06, 0x9e, 0x2c, 0x67, 0xab, 0x62, 0x3f, 0xa1, 0x29, 0x6d, 0x2a,
+	0x65, 0xe8, 0x6e, 0x3a, 0x55, 0x44, 0x62, 0xdd, 0x9d, 0xdd, 0x26, 0x81, 0x17, 0x6e, 0x17, 0x91,
+	0xec, 0xe8, 0x67, 0x8b, 0x66, 0xaa, 0x7f, 0x43, 0x35, 0xf5, 0xda, 0x1e, 0x94, 0x53, 0x09, 0x4c,
+	0x45, 0x26, 0xd9, 0x44, 0x6d, 0x97, 0x31, 0x61, 0x4b, 0x0c, 0xa5, 0xe6, 0x66, 0xf5, 0x1a, 0xd1,
+	0x1c, 0xcb, 0x76, 0x0f, 0x4c, 0x69, 0x15, 0x7e, 0x7b, 0x08, 0x4c, 0xa4, 0x0a, 0x71, 0xca, 0xf5,
+	0xfa, 0x2b, 0x73, 0x69, 0xad, 0xbe, 0x3b, 0xd4, 0xb5, 0xff, 0x25, 0xe5, 0x7a, 0x0a, 0x5b, 0xab,
+	0xf6, 0xaf, 0x4c, 0xf5, 0x29, 0x1b, 0x52, 0xf8, 0x36, 0x61, 0xb2, 0x73, 0x90, 0x6e, 0x54, 0xdf,
+	0xbf, 0x49, 0x38, 0x2b, 0x9d, 0x42, 0xb5, 0x35, 0x84, 0x8b, 0x68, 0x7f, 0xac, 0xbb, 0x01, 0x52,
+	0x97, 0x50, 0x44, 0x78, 0x11, 0x2d, 0x87, 0x59, 0x21, 0xa7, 0xda, 0x5d, 0xb3, 0x8a, 0x75, 0x11,
+	0xe6, 0x11, 0xc3, 0x3e, 0xae, 0x73, 0xe1, 0xe0, 0x58, 0xfa, 0x42, 0xa8, 0xf6, 0x27, 0x2c, 0xd3,
+	0x2a, 0xa3, 0x6a, 0x48, 0xee, 0xe6, 0xfb, 0x68, 0x33, 0xf4, 0xfd, 0x70, 0x5b, 0x50, 0x9d, 0xde,
+	0x41, 0xa8, 0x5a, 0xdf, 0x82, 0xff, 0x8f, 0xbd, 0x32, 0x5f, 0xe8, 0xf5, 0x8d, 0xc8, 0x35, 0xbf,
+	0x6f, 0x82, 0x89, 0x54, 0x69, 0x52, 0xe9, 0xb2, 0xbf, 0x56, 0x59, 0xd8, 0x25, 0xca, 0x5b, 0xbf,
+	0x6a, 0x76, 0xed, 0xff, 0x4a, 0x9c, 0x64, 0x8f, 0x9a, 0xaa, 0x15, 0xfb, 0x43, 0x43, 0x7d, 0xb2,
+	0xb8, 0x5d, 0xfa, 0xbf, 0xd5, 0xa7, 0xa7, 0x96, 0xff, 0x49, 0xeb, 0x53, 0x3e, 0xc0, 0x10, 0x17,
+	0x11, 0x1f, 0x7b, 0x8f, 0xa0, 0x5c, 0x45, 0x8d, 0x97, 0xc8, 0x79, 0xff, 0x4c, 0x5f, 0x0c, 0xfa,
+	0xba, 0x19, 0x95, 0x4d, 0xb5, 0x88, 0x0e, 0x27, 0xa5, 0xc6, 0x6c, 0x1c, 0xca, 0xf7, 0x03, 0x74,
+	0x24, 0xfa, 0x37, 0xa3, 0x6b, 0xff, 0x45, 0x22, 0xe4, 0xbd, 0x0a, 0x29, 0x8a, 0x45, 0x7f, 0x60,
+	0xa4, 0x0f, 0x50, 0x35, 0x28, 0x04, 0xcb, 0xf4, 0xeb, 0xa3, 0xd9, 0xd8, 0x4e, 0xd2, 0x2f, 0x89,
+	0xea, 0x61, 0xc0, 0x5d, 0x71, 0x34, 0x05, 0x71, 0x8b, 0x94, 0xa9, 0x65, 0x39, 0xa6, 0x2d, 0x36,
+	0x8b, 0x64, 0x2b, 0x40, 0x1d, 0x79, 0x14, 0xfb, 0x78, 0x4b, 0xc8, 0x67, 0x36, 0x31, 0x35, 0x19,
+	0xe1, 0x84, 0x50, 0xda, 0x2e, 0x15, 0xda, 0x8a, 0x6a, 0xb4, 0xc2, 0xd6, 0xaf, 0x65, 0xd5, 0xc2,
+	0x92, 0xc8, 0x27, 0x8e, 0x4b, 0x12, 0xd4, 0xfd, 0x8e, 0x87, 0xbd, 0x24, 0xbc, 0x1c, 0x80, 0xfb,
+	0xe7, 0xe5, 0x63, 0x3d, 0xb1, 0x7f, 0x14, 0x65, 0xfe, 0xc1, 0x04, 0x13, 0xa9, 0xcb, 0xba, 0xb2,
+	0xcc, 0xfe, 0xdb, 0x7b, 0x41, 0xd6, 0xad, 0xa2, 0x41, 0xeb, 0xf7, 0xcc, 0xae, 0xfd, 0xb3, 0x49,
+	0xa0, 0xd9, 0x2b, 0x0e, 0x90, 0xd8, 0x21, 0x0a, 0xff, 0x9c, 0x11, 0x55, 0x3c, 0x2e, 0x6d, 0x27,
+	0xb6, 0x50, 0xf9, 0xac, 0x44, 0x8c, 0x0a, 0x1b, 0xd8, 0x72, 0x89, 0xef, 0x6e, 0xf8, 0x38, 0xea,
+	0xca, 0x3e, 0xb2, 0xd5, 0xba, 0x5c, 0x8a, 0x58, 0x46, 0xef, 0xfb, 0x3c, 0x7c, 0xd2, 0x21, 0xdf,
+	0x55, 0xc0, 0x46, 0x87, 0xca, 0x4d, 0x55, 0xb8, 0x4f, 0xbf, 0x86, 0x52, 0x4f, 0x95, 0xb2, 0x3d,
+	0xed, 0xea, 0x16, 0xa6, 0x3b, 0xc8, 0xad, 0xd7, 0x31, 0x93, 0xe9, 0x82, 0xdb, 0xf1, 0x08, 0x4f,
+	0xcb, 0xf7, 0x28, 0x3c, 0xd2, 0x63, 0x96, 0xf3, 0x82, 0xf7, 0x39, 0xc1, 0x25, 0xfc, 0x79, 0x13,
+	0x4c, 0xf5, 0x5e, 0xe2, 0xe1, 0xd1, 0xe4, 0x92, 0xdb, 0x77, 0xb5, 0x2f, 0xf4, 0xdd, 0x80, 0xad,
+	0xf7, 0x8d, 0xae, 0xdd, 0x35, 0xc0, 0xde, 0xf4, 0x20, 0x83, 0x30, 0x5a, 0x40, 0xf6, 0x84, 0x55,
+	0x48, 0x68, 0x45, 0x63, 0x4a, 0x05, 0x6e, 0x87, 0x37, 0x71, 0xc0, 0x49, 0x5d, 0xca, 0xb2, 0xc3,
+	0x34, 0x6e, 0xa2, 0x8c, 0x5a, 0xcf, 0x93, 0x85, 0xcd, 0x8e, 0x2f, 0x9e, 0x87, 0x85, 0xe1, 0x2d,
+	0xf1, 0x76, 0x2a, 0xd6, 0x08, 0x61, 0xa8, 0xdd, 0xe1, 0x28, 0x54, 0x87, 0xe6, 0xb6, 0x4b, 0xb8,
+	0x4f, 0x18, 0x4f, 0x1c, 0xf3, 0x94, 0xf5, 0x44, 0x5a, 0x02, 0x51, 0x61, 0xe0, 0xad, 0xf9, 0xcc,
+	0xd5, 0x7d, 0xd1, 0x98, 0x81, 0xbf, 0x61, 0x02, 0xd8, 0x5f, 0x94, 0x80, 0xc7, 0x55, 0x2d, 0x7a,
+	0x97, 0x62, 0xc5, 0xae, 0xe1, 0xf1, 0xbb, 0x46, 0xd7, 0xfe, 0x72, 0x9f, 0x60, 0x0e, 0xa8, 0x85,
+	0x50, 0x7a, 0xf3, 0xc2, 0x5d, 0x35, 0xc8, 0xf4, 0xb1, 0x9f, 0x40, 0x22, 0x6d, 0x3f, 0x50, 0x56,
+	0xd1, 0x1b, 0x0c, 0x4f, 0x78, 0xb2, 0x94, 0x56, 0x83, 0x6c, 0xe1, 0x20, 0x32, 0x5d, 0x95, 0x35,
+	0xca, 0x79, 0xbb, 0xca, 0xe9, 0xa9, 0x99, 0x87, 0x91, 0x13, 0xfc, 0xc0, 0x50, 0x0d, 0xd7, 0x2c,
+	0x5b, 0xc7, 0xa2, 0x44, 0x6a, 0x50, 0x3d, 0xa6, 0x70, 0x7c, 0x17, 0xa8, 0x8e, 0x71, 0x3f, 0xd3,
+	0xb5, 0xaf, 0xf6, 0x19, 0x90, 0x40, 0xcf, 0xc8, 0x82, 0x15, 0x4e, 0xa6, 0x3d, 0x38, 0x03, 0xca,
+	0x4a, 0x23, 0x36, 0xfe, 0xa7, 0xe0, 0x43, 0xb1, 0xf4, 0x9b, 0x26, 0xd8, 0xdf, 0x57, 0x02, 0x4d,
+	0x58, 0x1a, 0x54, 0x19, 0xdd, 0x35, 0x73, 0xfc, 0x6b, 0xa3, 0x6b, 0x7f, 0xcd, 0x00, 0xe0, 0xa5,
+	0xd0, 0xc3, 0xda, 0x7e, 0x64, 0x41, 0x20, 0xbe, 0x1e, 0xe8, 0xc8, 0xfd, 0xf9, 0x41, 0x91, 0x5b,
+	0xe6, 0x8f, 0x42, 0x33, 0xfa, 0x25, 0xc5, 0x16, 0xc1, 0xdb, 0x99, 0x68, 0x9c, 0xbc, 0x56, 0xcc,
+	0x24, 0x95, 0xf1, 0x5b, 0x4b, 0x97, 0xe9, 0x94, 0xb2, 0xf7, 0x9a, 0xa0, 0x0f, 0xdf, 0xd4, 0x71,
+	0xda, 0x52, 0x04, 0x46, 0x7b, 0x0b, 0xa1, 0x0d, 0x49, 0xa1, 0x1d, 0x86, 0x87, 0x84, 0xd0, 0x5a,
+	0x31, 0x03, 0x51, 0x54, 0xfe, 0x63, 0x13, 0xec, 0x49, 0x57, 0x71, 0xd5, 0x71, 0x36, 0xa0, 0xae,
+	0x9b, 0xce, 0xfe, 0x3e, 0x67, 0x76, 0xed, 0xbf, 0xcb, 0xca, 0x63, 0xaf, 0x9e, 0xa2, 0xc3, 0xc2,
+	0x9f, 0x18, 0x76, 0x74, 0x45, 0x72, 0xb3, 0x52, 0x9a, 0x45, 0xdb, 0x4d, 0x52, 0x6f, 0xc6, 0x4f,
+	0xd7, 0x54, 0x70, 0x6d, 0x77, 0x36, 0x7c, 0xc2, 0x9a, 0x98, 0x21, 0xa2, 0x0d, 0xdf, 0xc3, 0x75,
+	0xf5, 0xb0, 0x58, 0xde, 0x85, 0xea, 0x21, 0x55, 0xf9, 0xb5, 0xf6, 0x1c, 0x8f, 0x70, 0xe4, 0x87,
+	0x8d, 0x47, 0x4b, 0xc3, 0x08, 0x93, 0xe7, 0x95, 0x26, 0xe7, 0x11, 0xa4, 0x77, 0xcc, 0x3a, 0xdc,
+	0x1b, 0x6f, 0xf5, 0x0d, 0x50, 0x44, 0x98, 0x6f, 0x99, 0x60, 0x22, 0x55, 0xd8, 0x86, 0xba, 0x3e,
+	0xdb, 0x5b, 0xe9, 0x4e, 0x0b, 0xf0, 0xf3, 0x66, 0xd7, 0xfe, 0x61, 0x56, 0x80, 0x7b, 0xd4, 0x0c,
+	0x2d, 0xbf, 0x6f, 0x1b, 0xea, 0xb3, 0x4f, 0x7c, 0xc9, 0xfb, 0x34, 0xf9, 0x54, 0x47, 0x1a, 0x17,
+	0x91, 0xef, 0x6c, 0xb7, 0x65, 0x60, 0x10, 0x67, 0x96, 0x7c, 0x4f, 0xf7, 0xff, 0x4f, 0x8a, 0x47,
+	0xad, 0xe9, 0x5e, 0x29, 0xaa, 0xf7, 0x42, 0x42, 0x88, 0x5f, 0x30, 0xc1, 0xd4, 0x45, 0xcc, 0x33,
+	0x7d, 0x9f, 0x5d, 0x4b, 0x11, 0x71, 0xcf, 0xc4, 0xfa, 0x9e, 0xd1, 0xb5, 0xff, 0xc8, 0x00, 0x23,
+	0xe2, 0x83, 0xc1, 0x83, 0x22, 0x3b, 0x10, 0x82, 0xd0, 0x6f, 0xb6, 0xe4, 0x2a, 0x85, 0x5f, 0xcb,
+	0x78, 0x65, 0x1a, 0xb4, 0x7b, 0x24, 0x8e, 0x0f, 0x2a, 0xf1, 0x21, 0xaf, 0xf4, 0x41, 0x18, 0xcf,
+	0xda, 0xc1, 0x5c, 0xdc, 0xe7, 0xa3, 0xcf, 0x38, 0x85, 0x92, 0x2f, 0xbc, 0xa4, 0x35, 0x93, 0x3a,
+	0xef, 0x50, 0x9c, 0x79, 0x0e, 0x29, 0xc6, 0x05, 0x66, 0xd8, 0xe1, 0xe2, 0xad, 0x59, 0xfa, 0x86,
+	0x1f, 0x87, 0xb6, 0xbd, 0x70, 0x42, 0x7a, 0x29, 0x9e, 0x97, 0xad, 0xa0, 0x6f, 0x19, 0xe0, 0xc0,
+	0x80, 0x76, 0x18, 0x7c, 0x3c, 0xb9, 0x9f, 0x0d, 0xea, 0x93, 0xa5, 0xc4, 0xf3, 0x69, 0xa3, 0x6b,
+	0xff, 0x74, 0x24, 0x9d, 0xc3, 0x6a, 0x4a, 0xbf, 0x80, 0x3e, 0x1a, 0x55, 0x4a, 0x42, 0xaa, 0xef,
+	0x37, 0x8f, 0x24, 0xaa, 0xa4, 0x56, 0x50, 0x48, 0x93, 0xbf, 0xa8, 0xfa, 0x59, 0x6d, 0x30, 0xa6,
+	0x9b, 0x71, 0x10, 0xea, 0x8c, 0x2f, 0xd5, 0x99, 0x4b, 0xd1, 0x7a, 0xb1, 0x6b, 0xcf, 0x44, 0xa4,
+	0x8a, 0xf2, 0x82, 0xdc, 0x3e, 0x5d, 0x26, 0x48, 0x93, 0x93, 0xc8, 0x6c, 0x0a, 0xee, 0x13, 0x9b,
+	0x0a, 0xa0, 0xce, 0xd0, 0x7f, 0x6c, 0x80, 0x7d, 0xd9, 0xd6, 0x1b, 0x3c, 0x12, 0x05, 0xf6, 0xbe,
+	0x46, 0x5f, 0xa1, 0x30, 0x08, 0xa4, 0xe3, 0xfe, 0xef, 0x18, 0x5d, 0xfb, 0xb3, 0xb1, 0x75, 0x1d,
+	0x48, 0x15, 0x0c, 0x84, 0x38, 0x24, 0x7d, 0x8d, 0xb4, 0x6d, 0x45, 0xb2, 0x92, 0x79, 0xa1, 0x50,
+	0xbc, 0xee, 0xd6, 0x29, 0xa3, 0x70, 0x33, 0xb3, 0x15, 0x3f, 0xb3, 0x3a, 0x7b, 0xce, 0x3c, 0xad,
+	0x4b, 0x49, 0x3f, 0x7a, 0xde, 0x97, 0xf0, 0xab, 0x23, 0x79, 0xc2, 0x6f, 0x14, 0xc9, 0xbf, 0xa2,
+	0xd9, 0x4e, 0x9a, 0xf1, 0xbb, 0xfa, 0x4f, 0xcc, 0x73, 0x7f, 0xe3, 0xde, 0xaa, 0x77, 0xed, 0x65,
+	0x00, 0x52, 0x8b, 0x4c, 0x4a, 0xb6, 0x93, 0x66, 0x7d, 0x72, 0x62, 0x8b, 0xcb, 0x54, 0x32, 0x9e,
+	0x3e, 0xd2, 0x84, 0x27, 0xf4, 0xa9, 0x28, 0x41, 0x85, 0xdf, 0x34, 0xc0, 0xbe, 0xec, 0xeb, 0x08,
+	0xa5, 0xa2, 0x81, 0x2f, 0x26, 0x0a, 0x99, 0x77, 0x03, 0xd2, 0xa6, 0x5f, 0xcd, 0x52, 0xa8, 0xa6,
+	0xc5, 0x4f, 0x44, 0x0b, 0x8b, 0xd9, 0xd2, 0x5f, 0x34, 0x3e, 0x20, 0x56, 0xb9, 0x81, 0xdb, 0xc0,
+	0x29, 0x26, 0x92, 0x68, 0x55, 0xb0, 0x7a, 0x88, 0x5e, 0x8c, 0x1f, 0x4a, 0xc0, 0x3f, 0x33, 0xc0,
+	0xbe, 0xec, 0x7b, 0x0c, 0x45, 0xfe, 0xc0, 0x37, 0x1a, 0x3d, 0xe4, 0x7f, 0xce, 0xe8, 0xda, 0xeb,
+	0x59, 0xf2, 0xd5, 0xb4, 0x84, 0xfc, 0x8f, 0x0d, 0x2a, 0x9f, 0xfc, 0x8f, 0x98, 0x38, 0x51, 0x38,
+	0x90, 0x65, 0x42, 0xd5, 0x0a, 0x12, 0x4e, 0xfe, 0xc6, 0x00, 0xfb, 0xb2, 0x6f, 0x3e, 0x14, 0x27,
+	0x03, 0xdf, 0x81, 0xec, 0x9a, 0x15, 0xbf, 0x6b, 0x74, 0x6d, 0x9e, 0xe5, 0x49, 0x2d, 0x90, 0xf0,
+	0x74, 0x23, 0xae, 0x1c, 0xc4, 0x63, 0xd2, 0x2b, 0x28, 0x6e, 0xc9, 0x2c, 0x81, 0x70, 0xf5, 0x3b,
+	0x09, 0x61, 0x53, 0xfa, 0xe0, 0x78, 0x14, 0x46, 0x0f, 0xcd, 0x0c, 0x62, 0x14, 0x7e, 0x46, 0xdd,
+	0x39, 0xe3, 0xf7, 0x1e, 0xd1, 0x9d, 0xb3, 0xe7, 0xa5, 0x49, 0x21, 0x7e, 0xf9, 0x21, 0x06, 0xad,
+	0x3f, 0x37, 0xba, 0xf6, 0x7b, 0x46, 0x86, 0x1f, 0x79, 0xef, 0xe4, 0x6e, 0x03, 0xd5, 0x05, 0x46,
+	0xe1, 0x8b, 0x99, 0x23, 0xa5, 0x15, 0xaa, 0x3c, 0xdc, 0x43, 0xf2, 0xb1, 0x74, 0x72, 0x1e, 0xc4,
+	0x3f, 0x15, 0x88, 0xdf, 0xe9, 0x4b, 0x9e, 0x7d, 0xb2, 0x95, 0xcd, 0x0e, 0x9b, 0xee, 0x16, 0x0e,
+	0x4e, 0x72, 0x84, 0x65, 0x11, 0x71, 0x07, 0xf3, 0xdd, 0xae, 0xdc, 0xa9, 0x42, 0xa2, 0xac, 0x01,
+	0xee, 0x60, 0x97, 0xaa, 0x77, 0xcf, 0x61, 0x27, 0xc8, 0x5c, 0x11, 0x65, 0x69, 0xfd, 0xcc, 0xbc,
+	0x20, 0x48, 0xe4, 0x78, 0xda, 0x45, 0x92, 0x3e, 0xe6, 0x6e, 0xa1, 0x41, 0xbe, 0xdc, 0x8a, 0xd1,
+	0xac, 0xcf, 0xaa, 0x4c, 0x2f, 0x57, 0x71, 0x7d, 0x1c, 0x78, 0x2e, 0x85, 0x85, 0xd8, 0xd7, 0xd4,
+	0x00, 0xda, 0xc4, 0x82, 0x6f, 0x81, 0x5c, 0xf8, 0x86, 0x51, 0x49, 0xbd, 0x92, 0xaf, 0x53, 0xcc,
+	0x15, 0x40, 0x26, 0xbe, 0x32, 0x3e, 0x62, 0xca, 0xc2, 0xc0, 0xf5, 0x7b, 0x66, 0xdf, 0xe7, 0x14,
+	0x96, 0x57, 0x20, 0x81, 0xa3, 0xcb, 0x1b, 0x2c, 0x65, 0x20, 0x99, 0xdb, 0x22, 0xd5, 0xd7, 0x50,
+	0xf1, 0x7b, 0x9b, 0x90, 0xa6, 0x1f, 0xf6, 0xcb, 0x10, 0xa0, 0xe8, 0xa0, 0xb2, 0x0d, 0x17, 0xc5,
+	0x6a, 0xbc, 0x45, 0xc2, 0x0e, 0x43, 0x61, 0x80, 0x93, 0x23, 0x6c, 0xda, 0xda, 0xaf, 0x8f, 0x30,
+	0xb1, 0xeb, 0x9c, 0x9c, 0x26, 0xd2, 0x93, 0x3f, 0x95, 0x0d, 0x24, 0x31, 0xfb, 0xc1, 0x22, 0xdc,
+	0xcd, 0x4b, 0xde, 0x36, 0xba, 0xf6, 0x46, 0x5a, 0x94, 0x6a, 0xc1, 0x81, 0xa2, 0x5c, 0x74, 0x52,
+	0xa4, 0x0e, 0x40, 0x78, 0xa8, 0xe3, 0x78, 0xa6, 0x9f, 0x17, 0xf8, 0xde, 0x10, 0xd8, 0x93, 0x6e,
+	0x8d, 0xaa, 0x7c, 0x7f, 0x40, 0xcb, 0xb5, 0x90, 0xef, 0x07, 0xe8, 0x23, 0xe2, 0x5f, 0xcd, 0xae,
+	0xfd, 0x6d, 0x13, 0xe4, 0x44, 0x6f, 0x47, 0x34, 0x3f, 0xa1, 0x8e, 0xe5, 0x48, 0x0f, 0x14, 0x7e,
+	0x37, 0xd5, 0x7b, 0x89, 0x75, 0xae, 0x81, 0x0f, 0xb8, 0xfd, 0xaa, 0x82, 0x96, 0xba, 0x14, 0x6c,
+	0x84, 0x9c, 0x29, 0x13, 0x10, 0xf3, 0xe4, 0x6b, 0x7a, 0x91, 0x7f, 0x2a, 0xfb, 0xb7, 0x3b, 0xbc,
+	0x19, 0x52, 0x72, 0x47, 0x5d, 0xa9, 0x9b, 0xd8, 0xf5, 0x30, 0x45, 0x2e, 0x43, 0x1b, 0xd8, 0xa5,
+	0x98, 0x46, 0x52, 0xa2, 0x11, 0xfa, 0x27, 0xe6, 0xec, 0x36, 0x99, 0xbb, 0x82, 0x77, 0x34, 0x6a,
+	0x11, 0x69, 0x3e, 0x53, 0xfe, 0x2a, 0xa9, 0x13, 0x31, 0x47, 0xf8, 0x63, 0x64, 0xb0, 0x51, 0x37,
+	0x3d, 0xa2, 0xfb, 0x16, 0xde, 0x89, 0xae, 0x30, 0xad, 0x0e, 0xe3, 0x68, 0x03, 0xa3, 0x06, 0x75,
+	0x85, 0xf3, 0xa5, 0x4b, 0x4a, 0xc5, 0x88, 0x5d, 0x19, 0xb9, 0x4e, 0x4a, 0xb4, 0xa8, 0xc2, 0xa4,
+	0x8b, 0xc0, 0x11, 0x46, 0xa2, 0xb0, 0x82, 0x35, 0xa9, 0x15, 0xe6, 0xb6, 0xc9, 0x9c, 0x98, 0xbc,
+	0x18, 0xb5, 0xab, 0xa1, 0x68, 0x0a, 0xa7, 0x7a, 0xd0, 0xbb, 0x1a, 0xdf, 0xe1, 0xe8, 0x68, 0xef,
+	0x69, 0x56, 0x5b, 0x5f, 0x36, 0xba, 0xf6, 0xdb, 0x46, 0x4a, 0x69, 0x7b, 0x05, 0x56, 0x4c, 0x66,
+	0xa1, 0x21, 0x3e, 0x93, 0x9e, 0x9d, 0x24, 0x7d, 0x57, 0x55, 0xa5, 0xd3, 0x16, 0xd5, 0xf9, 0xf7,
+	0xe4, 0x0c, 0xa5, 0x2d, 0xed, 0xfb, 0x72, 0x89, 0xa8, 0x48, 0xd8, 0x9f, 0xec, 0xee, 0x87, 0xbd,
+	0xdc, 0xc2, 0xef, 0xcb, 0xb7, 0x35, 0x49, 0xab, 0x5c, 0x19, 0xe7, 0x80, 0xe6, 0xf9, 0xae, 0x3e,
+	0xf6, 0x25, 0xa3, 0x6b, 0xef, 0xa4, 0x2d, 0x53, 0x4d, 0x8e, 0x2d, 0xf3, 0xb5, 0xc8, 0xaf, 0x12,
+	0xf9, 0xdf, 0x2f, 0xfc, 0x0c, 0xb6, 0x11, 0x75, 0x3b, 0x17, 0x37, 0x17, 0x71, 0x99, 0x6a, 0xb5,
+	0xb0, 0x47, 0x5c, 0x8e, 0xfd, 0x94, 0x26, 0xa7, 0x67, 0x0e, 0xf6, 0xf0, 0x26, 0xcf, 0xa3, 0xf2,
+	0x57, 0x87, 0xba, 0xf6, 0x6f, 0x0f, 0xc1, 0x26, 0x38, 0x24, 0xbb, 0xb0, 0x28, 0xd5, 0x86, 0x15,
+	0xe4, 0x58, 0x97, 0xc1, 0xc1, 0xba, 0x00, 0xd4, 0x93, 0xf1, 0x39, 0xb7, 0x4d, 0x60, 0x29, 0xfa,
+	0x05, 0x42, 0x83, 0xf0, 0x66, 0x67, 0xa3, 0x58, 0x0f, 0x5b, 0xf3, 0x0c, 0x6f, 0xb8, 0x8c, 0x13,
+	0x37, 0xa0, 0x21, 0xab, 0x37, 0xe7, 0x7b, 0xe7, 0x95, 0x86, 0xce, 0x14, 0x17, 0xac, 0x61, 0x41,
+	0xc0, 0x8c, 0x69, 0x98, 0xa5, 0x29, 0xb7, 0xdd, 0xf6, 0x05, 0x73, 0xe2, 0xf2, 0xff, 0x86, 0xf8,
+	0x71, 0x46, 0xdf, 0x88, 0xf3, 0x3c, 0x18, 0x3a, 0xb7, 0x70, 0x0e, 0x9e, 0x03, 0x33, 0x8e, 0x56,
+	0x97, 0x28, 0xb3, 0x47, 0x8d, 0x2b, 0x16, 0x76, 0x68, 0x1d, 0x23, 0x2f, 0xc4, 0xea, 0x06, 0x28,
+	0x53, 0x93, 0x22, 0x1c, 0x05, 0xc3, 0xbf, 0x62, 0x1a, 0x63, 0xce, 0x8b, 0x60, 0xe8, 0xfc, 0xc2,
+	0x59, 0xf8, 0x2c, 0xb8, 0x70, 0x9f, 0xc9, 0x84, 0x21, 0x8e, 0x5b, 0xed, 0x90, 0xba, 0x94, 0x88,
+	0x1f, 0x2c, 0x06, 0x71, 0x41, 0xb6, 0xe8, 0xf8, 0x62, 0xf7, 0x33, 0x10, 0x83, 0xfa, 0x7d, 0x16,
+	0x10, 0xfd, 0x33, 0x42, 0x31, 0x4b, 0xab, 0x4b, 0xfe, 0x34, 0x30, 0xf0, 0x50, 0x10, 0xf6, 0x8e,
+	0xa6, 0x3a, 0xce, 0x68, 0x1b, 0x53, 0x1c, 0xff, 0x14, 0xb2, 0x78, 0x73, 0x3f, 0x98, 0x04, 0xe3,
+	0x65, 0x97, 0x91, 0xba, 0x88, 0x24, 0xd0, 0xcc, 0x19, 0x1b, 0x93, 0x60, 0x6f, 0x7a, 0xe8, 0xb1,
+	0x9b, 0xe6, 0xd6, 0x99, 0x8d, 0x51, 0x69, 0x61, 0x67, 0xff, 0x7b, 0x00, 0x23, 0x1c, 0x72, 0x6e,
+	0x7e, 0x3e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CouchConnectionsClient is the client API for CouchConnections service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CouchConnectionsClient interface {
+	// GetVersion returns the API version.
+	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Version, error)
+	// CreateEvent creates a new event.
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// GetEvent returns a single event.
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// ListEvents returns a page of events.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// UpdateEvent updates an existing event.
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// DeleteEvent deletes an event.
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SearchEvents returns the events that match a search query.
+	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error)
+	// GetJoinLink returns the join link of an event to its owners, admins and confirmed attendees. Every access is audited.
+	GetJoinLink(ctx context.Context, in *GetJoinLinkRequest, opts ...grpc.CallOption) (*JoinLink, error)
+	// RegisterForEvent registers the authenticated user for an event.
+	RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*Registration, error)
+	// CancelRegistration cancels the registration of the authenticated user for an event.
+	CancelRegistration(ctx context.Context, in *CancelRegistrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListRegistrations returns the registrations for an event.
+	// The host, co-hosts and admins get all registrations, other users only their own.
+	ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error)
+	// ListPendingEvents returns the events that wait for review. Only admins can moderate events.
+	ListPendingEvents(ctx context.Context, in *ListPendingEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// ApproveEvent approves a pending event and publishes it. Only admins can moderate events.
+	ApproveEvent(ctx context.Context, in *ApproveEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// RejectEvent rejects a pending event. Only admins can moderate events.
+	RejectEvent(ctx context.Context, in *RejectEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// GetMyHostProfile returns the host profile of the authenticated user.
+	GetMyHostProfile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Host, error)
+	// UpdateMyHostProfile creates or updates the host profile of the authenticated user.
+	UpdateMyHostProfile(ctx context.Context, in *UpdateMyHostProfileRequest, opts ...grpc.CallOption) (*Host, error)
+	// GetHost returns a host profile.
+	GetHost(ctx context.Context, in *GetHostRequest, opts ...grpc.CallOption) (*Host, error)
+	// ListHostEvents returns the upcoming and past events of a host.
+	ListHostEvents(ctx context.Context, in *ListHostEventsRequest, opts ...grpc.CallOption) (*ListHostEventsResponse, error)
+	// ListCategories returns all categories.
+	ListCategories(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// CreateCategory creates a new category. Only admins can manage categories.
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// UpdateCategory updates an existing category. Only admins can manage categories.
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// DeleteCategory deletes a category and removes it from all events. Only admins can manage categories.
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetTagCloud returns the number of upcoming events per tag.
+	GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*TagCloud, error)
+	// CreateFeedToken creates a secret token for the personal calendar feed of the authenticated user.
+	CreateFeedToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeedToken, error)
+	// RevokeFeedToken revokes the calendar feed token of the authenticated user.
+	RevokeFeedToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateAPIKey creates a personal API key of the authenticated user.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists the API keys of the authenticated user.
+	ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey revokes an API key of the authenticated user.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type couchConnectionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCouchConnectionsClient(cc grpc.ClientConnInterface) CouchConnectionsClient {
+	return &couchConnectionsClient{cc}
+}
+
+func (c *couchConnectionsClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Version, error) {
+	out := new(Version)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/CreateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/UpdateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/DeleteEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error) {
+	out := new(SearchEventsResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/SearchEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) GetJoinLink(ctx context.Context, in *GetJoinLinkRequest, opts ...grpc.CallOption) (*JoinLink, error) {
+	out := new(JoinLink)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetJoinLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*Registration, error) {
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/RegisterForEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) CancelRegistration(ctx context.Context, in *CancelRegistrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/CancelRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error) {
+	out := new(ListRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ListRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ListPendingEvents(ctx context.Context, in *ListPendingEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ListPendingEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ApproveEvent(ctx context.Context, in *ApproveEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ApproveEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) RejectEvent(ctx context.Context, in *RejectEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/RejectEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) GetMyHostProfile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Host, error) {
+	out := new(Host)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetMyHostProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) UpdateMyHostProfile(ctx context.Context, in *UpdateMyHostProfileRequest, opts ...grpc.CallOption) (*Host, error) {
+	out := new(Host)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/UpdateMyHostProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) GetHost(ctx context.Context, in *GetHostRequest, opts ...grpc.CallOption) (*Host, error) {
+	out := new(Host)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ListHostEvents(ctx context.Context, in *ListHostEventsRequest, opts ...grpc.CallOption) (*ListHostEventsResponse, error) {
+	out := new(ListHostEventsResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ListHostEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ListCategories(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*TagCloud, error) {
+	out := new(TagCloud)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/GetTagCloud", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) CreateFeedToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeedToken, error) {
+	out := new(FeedToken)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/CreateFeedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) RevokeFeedToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/RevokeFeedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couchConnectionsClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.CouchConnections/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CouchConnectionsServer is the server API for CouchConnections service.
+type CouchConnectionsServer interface {
+	// GetVersion returns the API version.
+	GetVersion(context.Context, *empty.Empty) (*Version, error)
+	// CreateEvent creates a new event.
+	CreateEvent(context.Context, *CreateEventRequest) (*Event, error)
+	// GetEvent returns a single event.
+	GetEvent(context.Context, *GetEventRequest) (*Event, error)
+	// ListEvents returns a page of events.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// UpdateEvent updates an existing event.
+	UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error)
+	// DeleteEvent deletes an event.
+	DeleteEvent(context.Context, *DeleteEventRequest) (*empty.Empty, error)
+	// SearchEvents returns the events that match a search query.
+	SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error)
+	// GetJoinLink returns the join link of an event to its owners, admins and confirmed attendees. Every access is audited.
+	GetJoinLink(context.Context, *GetJoinLinkRequest) (*JoinLink, error)
+	// RegisterForEvent registers the authenticated user for an event.
+	RegisterForEvent(context.Context, *RegisterForEventRequest) (*Registration, error)
+	// CancelRegistration cancels the registration of the authenticated user for an event.
+	CancelRegistration(context.Context, *CancelRegistrationRequest) (*empty.Empty, error)
+	// ListRegistrations returns the registrations for an event.
+	// The host, co-hosts and admins get all registrations, other users only their own.
+	ListRegistrations(context.Context, *ListRegistrationsRequest) (*ListRegistrationsResponse, error)
+	// ListPendingEvents returns the events that wait for review. Only admins can moderate events.
+	ListPendingEvents(context.Context, *ListPendingEventsRequest) (*ListEventsResponse, error)
+	// ApproveEvent approves a pending event and publishes it. Only admins can moderate events.
+	ApproveEvent(context.Context, *ApproveEventRequest) (*Event, error)
+	// RejectEvent rejects a pending event. Only admins can moderate events.
+	RejectEvent(context.Context, *RejectEventRequest) (*Event, error)
+	// GetMyHostProfile returns the host profile of the authenticated user.
+	GetMyHostProfile(context.Context, *empty.Empty) (*Host, error)
+	// UpdateMyHostProfile creates or updates the host profile of the authenticated user.
+	UpdateMyHostProfile(context.Context, *UpdateMyHostProfileRequest) (*Host, error)
+	// GetHost returns a host profile.
+	GetHost(context.Context, *GetHostRequest) (*Host, error)
+	// ListHostEvents returns the upcoming and past events of a host.
+	ListHostEvents(context.Context, *ListHostEventsRequest) (*ListHostEventsResponse, error)
+	// ListCategories returns all categories.
+	ListCategories(context.Context, *empty.Empty) (*ListCategoriesResponse, error)
+	// CreateCategory creates a new category. Only admins can manage categories.
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	// UpdateCategory updates an existing category. Only admins can manage categories.
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
+	// DeleteCategory deletes a category and removes it from all events. Only admins can manage categories.
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*empty.Empty, error)
+	// GetTagCloud returns the number of upcoming events per tag.
+	GetTagCloud(context.Context, *GetTagCloudRequest) (*TagCloud, error)
+	// CreateFeedToken creates a secret token for the personal calendar feed of the authenticated user.
+	CreateFeedToken(context.Context, *empty.Empty) (*FeedToken, error)
+	// RevokeFeedToken revokes the calendar feed token of the authenticated user.
+	RevokeFeedToken(context.Context, *empty.Empty) (*empty.Empty, error)
+	// CreateAPIKey creates a personal API key of the authenticated user.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists the API keys of the authenticated user.
+	ListAPIKeys(context.Context, *empty.Empty) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey revokes an API key of the authenticated user.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error)
+}
+
+// UnimplementedCouchConnectionsServer can be embedded to have forward compatible implementations.
+type UnimplementedCouchConnectionsServer struct {
+}
+
+func (*UnimplementedCouchConnectionsServer) GetVersion(ctx context.Context, req *empty.Empty) (*Version, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) CreateEvent(ctx context.Context, req *CreateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) GetEvent(ctx context.Context, req *GetEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ListEvents(ctx context.Context, req *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) UpdateEvent(ctx context.Context, req *UpdateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) DeleteEvent(ctx context.Context, req *DeleteEventRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) SearchEvents(ctx context.Context, req *SearchEventsRequest) (*SearchEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEvents not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) GetJoinLink(ctx context.Context, req *GetJoinLinkRequest) (*JoinLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinLink not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) RegisterForEvent(ctx context.Context, req *RegisterForEventRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) CancelRegistration(ctx context.Context, req *CancelRegistrationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRegistration not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ListRegistrations(ctx context.Context, req *ListRegistrationsRequest) (*ListRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegistrations not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ListPendingEvents(ctx context.Context, req *ListPendingEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingEvents not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ApproveEvent(ctx context.Context, req *ApproveEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) RejectEvent(ctx context.Context, req *RejectEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectEvent not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) GetMyHostProfile(ctx context.Context, req *empty.Empty) (*Host, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyHostProfile not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) UpdateMyHostProfile(ctx context.Context, req *UpdateMyHostProfileRequest) (*Host, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyHostProfile not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) GetHost(ctx context.Context, req *GetHostRequest) (*Host, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHost not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ListHostEvents(ctx context.Context, req *ListHostEventsRequest) (*ListHostEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHostEvents not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ListCategories(ctx context.Context, req *empty.Empty) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) DeleteCategory(ctx context.Context, req *DeleteCategoryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) GetTagCloud(ctx context.Context, req *GetTagCloudRequest) (*TagCloud, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagCloud not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) CreateFeedToken(ctx context.Context, req *empty.Empty) (*FeedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedToken not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) RevokeFeedToken(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeedToken not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) ListAPIKeys(ctx context.Context, req *empty.Empty) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedCouchConnectionsServer) RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+
+func RegisterCouchConnectionsServer(s *grpc.Server, srv CouchConnectionsServer) {
+	s.RegisterService(&_CouchConnections_serviceDesc, srv)
+}
+
+func _CouchConnections_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).GetVersion(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/CreateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/GetEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/UpdateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/DeleteEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_SearchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).SearchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/SearchEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).SearchEvents(ctx, req.(*SearchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_GetJoinLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).GetJoinLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/GetJoinLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).GetJoinLink(ctx, req.(*GetJoinLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_RegisterForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).RegisterForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/RegisterForEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).RegisterForEvent(ctx, req.(*RegisterForEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_CancelRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).CancelRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/CancelRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).CancelRegistration(ctx, req.(*CancelRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ListRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ListRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ListRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ListRegistrations(ctx, req.(*ListRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ListPendingEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ListPendingEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ListPendingEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ListPendingEvents(ctx, req.(*ListPendingEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ApproveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ApproveEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ApproveEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ApproveEvent(ctx, req.(*ApproveEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_RejectEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).RejectEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/RejectEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).RejectEvent(ctx, req.(*RejectEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_GetMyHostProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).GetMyHostProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/GetMyHostProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).GetMyHostProfile(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_UpdateMyHostProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyHostProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).UpdateMyHostProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/UpdateMyHostProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).UpdateMyHostProfile(ctx, req.(*UpdateMyHostProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_GetHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).GetHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/GetHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).GetHost(ctx, req.(*GetHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ListHostEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHostEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ListHostEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ListHostEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ListHostEvents(ctx, req.(*ListHostEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ListCategories(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_GetTagCloud_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagCloudRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).GetTagCloud(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/GetTagCloud",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).GetTagCloud(ctx, req.(*GetTagCloudRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_CreateFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).CreateFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/CreateFeedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).CreateFeedToken(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_RevokeFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).RevokeFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/RevokeFeedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).RevokeFeedToken(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).ListAPIKeys(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouchConnections_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouchConnectionsServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CouchConnections/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouchConnectionsServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CouchConnections_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.CouchConnections",
+	HandlerType: (*CouchConnectionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVersion",
+			Handler:    _CouchConnections_GetVersion_Handler,
+		},
+		{
+			MethodName: "CreateEvent",
+			Handler:    _CouchConnections_CreateEvent_Handler,
+		},
+		{
+			MethodName: "GetEvent",
+			Handler:    _CouchConnections_GetEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _CouchConnections_ListEvents_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _CouchConnections_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _CouchConnections_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "SearchEvents",
+			Handler:    _CouchConnections_SearchEvents_Handler,
+		},
+		{
+			MethodName: "GetJoinLink",
+			Handler:    _CouchConnections_GetJoinLink_Handler,
+		},
+		{
+			MethodName: "RegisterForEvent",
+			Handler:    _CouchConnections_RegisterForEvent_Handler,
+		},
+		{
+			MethodName: "CancelRegistration",
+			Handler:    _CouchConnections_CancelRegistration_Handler,
+		},
+		{
+			MethodName: "ListRegistrations",
+			Handler:    _CouchConnections_ListRegistrations_Handler,
+		},
+		{
+			MethodName: "ListPendingEvents",
+			Handler:    _CouchConnections_ListPendingEvents_Handler,
+		},
+		{
+			MethodName: "ApproveEvent",
+			Handler:    _CouchConnections_ApproveEvent_Handler,
+		},
+		{
+			MethodName: "RejectEvent",
+			Handler:    _CouchConnections_RejectEvent_Handler,
+		},
+		{
+			MethodName: "GetMyHostProfile",
+			Handler:    _CouchConnections_GetMyHostProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMyHostProfile",
+			Handler:    _CouchConnections_UpdateMyHostProfile_Handler,
+		},
+		{
+			MethodName: "GetHost",
+			Handler:    _CouchConnections_GetHost_Handler,
+		},
+		{
+			MethodName: "ListHostEvents",
+			Handler:    _CouchConnections_ListHostEvents_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _CouchConnections_ListCategories_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _CouchConnections_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _CouchConnections_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _CouchConnections_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "GetTagCloud",
+			Handler:    _CouchConnections_GetTagCloud_Handler,
+		},
+		{
+			MethodName: "CreateFeedToken",
+			Handler:    _CouchConnections_CreateFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeFeedToken",
+			Handler:    _CouchConnections_RevokeFeedToken_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _CouchConnections_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _CouchConnections_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _CouchConnections_RevokeAPIKey_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
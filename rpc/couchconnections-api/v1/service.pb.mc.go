@@ -55,6 +55,546 @@ func (mr *MockCouchConnectionsClientMockRecorder) GetVersion(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockCouchConnectionsClient)(nil).GetVersion), varargs...)
 }
 
+// CreateEvent mocks base method
+func (m *MockCouchConnectionsClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEvent", varargs...)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent
+func (mr *MockCouchConnectionsClientMockRecorder) CreateEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).CreateEvent), varargs...)
+}
+
+// GetEvent mocks base method
+func (m *MockCouchConnectionsClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEvent", varargs...)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent
+func (mr *MockCouchConnectionsClientMockRecorder) GetEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).GetEvent), varargs...)
+}
+
+// ListEvents mocks base method
+func (m *MockCouchConnectionsClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEvents", varargs...)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents
+func (mr *MockCouchConnectionsClientMockRecorder) ListEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ListEvents), varargs...)
+}
+
+// UpdateEvent mocks base method
+func (m *MockCouchConnectionsClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEvent", varargs...)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent
+func (mr *MockCouchConnectionsClientMockRecorder) UpdateEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).UpdateEvent), varargs...)
+}
+
+// DeleteEvent mocks base method
+func (m *MockCouchConnectionsClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEvent", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent
+func (mr *MockCouchConnectionsClientMockRecorder) DeleteEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).DeleteEvent), varargs...)
+}
+
+// SearchEvents mocks base method
+func (m *MockCouchConnectionsClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchEvents", varargs...)
+	ret0, _ := ret[0].(*SearchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents
+func (mr *MockCouchConnectionsClientMockRecorder) SearchEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockCouchConnectionsClient)(nil).SearchEvents), varargs...)
+}
+
+// GetJoinLink mocks base method
+func (m *MockCouchConnectionsClient) GetJoinLink(ctx context.Context, in *GetJoinLinkRequest, opts ...grpc.CallOption) (*JoinLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetJoinLink", varargs...)
+	ret0, _ := ret[0].(*JoinLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinLink indicates an expected call of GetJoinLink
+func (mr *MockCouchConnectionsClientMockRecorder) GetJoinLink(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinLink", reflect.TypeOf((*MockCouchConnectionsClient)(nil).GetJoinLink), varargs...)
+}
+
+// RegisterForEvent mocks base method
+func (m *MockCouchConnectionsClient) RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*Registration, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterForEvent", varargs...)
+	ret0, _ := ret[0].(*Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterForEvent indicates an expected call of RegisterForEvent
+func (mr *MockCouchConnectionsClientMockRecorder) RegisterForEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterForEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).RegisterForEvent), varargs...)
+}
+
+// CancelRegistration mocks base method
+func (m *MockCouchConnectionsClient) CancelRegistration(ctx context.Context, in *CancelRegistrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelRegistration", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRegistration indicates an expected call of CancelRegistration
+func (mr *MockCouchConnectionsClientMockRecorder) CancelRegistration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRegistration", reflect.TypeOf((*MockCouchConnectionsClient)(nil).CancelRegistration), varargs...)
+}
+
+// ListRegistrations mocks base method
+func (m *MockCouchConnectionsClient) ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegistrations", varargs...)
+	ret0, _ := ret[0].(*ListRegistrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistrations indicates an expected call of ListRegistrations
+func (mr *MockCouchConnectionsClientMockRecorder) ListRegistrations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistrations", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ListRegistrations), varargs...)
+}
+
+// ListPendingEvents mocks base method
+func (m *MockCouchConnectionsClient) ListPendingEvents(ctx context.Context, in *ListPendingEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPendingEvents", varargs...)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingEvents indicates an expected call of ListPendingEvents
+func (mr *MockCouchConnectionsClientMockRecorder) ListPendingEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingEvents", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ListPendingEvents), varargs...)
+}
+
+// ApproveEvent mocks base method
+func (m *MockCouchConnectionsClient) ApproveEvent(ctx context.Context, in *ApproveEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveEvent", varargs...)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveEvent indicates an expected call of ApproveEvent
+func (mr *MockCouchConnectionsClientMockRecorder) ApproveEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ApproveEvent), varargs...)
+}
+
+// RejectEvent mocks base method
+func (m *MockCouchConnectionsClient) RejectEvent(ctx context.Context, in *RejectEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RejectEvent", varargs...)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectEvent indicates an expected call of RejectEvent
+func (mr *MockCouchConnectionsClientMockRecorder) RejectEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectEvent", reflect.TypeOf((*MockCouchConnectionsClient)(nil).RejectEvent), varargs...)
+}
+
+// GetMyHostProfile mocks base method
+func (m *MockCouchConnectionsClient) GetMyHostProfile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Host, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMyHostProfile", varargs...)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyHostProfile indicates an expected call of GetMyHostProfile
+func (mr *MockCouchConnectionsClientMockRecorder) GetMyHostProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyHostProfile", reflect.TypeOf((*MockCouchConnectionsClient)(nil).GetMyHostProfile), varargs...)
+}
+
+// UpdateMyHostProfile mocks base method
+func (m *MockCouchConnectionsClient) UpdateMyHostProfile(ctx context.Context, in *UpdateMyHostProfileRequest, opts ...grpc.CallOption) (*Host, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMyHostProfile", varargs...)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMyHostProfile indicates an expected call of UpdateMyHostProfile
+func (mr *MockCouchConnectionsClientMockRecorder) UpdateMyHostProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMyHostProfile", reflect.TypeOf((*MockCouchConnectionsClient)(nil).UpdateMyHostProfile), varargs...)
+}
+
+// GetHost mocks base method
+func (m *MockCouchConnectionsClient) GetHost(ctx context.Context, in *GetHostRequest, opts ...grpc.CallOption) (*Host, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHost", varargs...)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHost indicates an expected call of GetHost
+func (mr *MockCouchConnectionsClientMockRecorder) GetHost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHost", reflect.TypeOf((*MockCouchConnectionsClient)(nil).GetHost), varargs...)
+}
+
+// ListHostEvents mocks base method
+func (m *MockCouchConnectionsClient) ListHostEvents(ctx context.Context, in *ListHostEventsRequest, opts ...grpc.CallOption) (*ListHostEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostEvents", varargs...)
+	ret0, _ := ret[0].(*ListHostEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostEvents indicates an expected call of ListHostEvents
+func (mr *MockCouchConnectionsClientMockRecorder) ListHostEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostEvents", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ListHostEvents), varargs...)
+}
+
+// ListCategories mocks base method
+func (m *MockCouchConnectionsClient) ListCategories(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCategories", varargs...)
+	ret0, _ := ret[0].(*ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories
+func (mr *MockCouchConnectionsClientMockRecorder) ListCategories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ListCategories), varargs...)
+}
+
+// CreateCategory mocks base method
+func (m *MockCouchConnectionsClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCategory", varargs...)
+	ret0, _ := ret[0].(*Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory
+func (mr *MockCouchConnectionsClientMockRecorder) CreateCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCouchConnectionsClient)(nil).CreateCategory), varargs...)
+}
+
+// UpdateCategory mocks base method
+func (m *MockCouchConnectionsClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCategory", varargs...)
+	ret0, _ := ret[0].(*Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory
+func (mr *MockCouchConnectionsClientMockRecorder) UpdateCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCouchConnectionsClient)(nil).UpdateCategory), varargs...)
+}
+
+// DeleteCategory mocks base method
+func (m *MockCouchConnectionsClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCategory", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory
+func (mr *MockCouchConnectionsClientMockRecorder) DeleteCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCouchConnectionsClient)(nil).DeleteCategory), varargs...)
+}
+
+// GetTagCloud mocks base method
+func (m *MockCouchConnectionsClient) GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*TagCloud, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTagCloud", varargs...)
+	ret0, _ := ret[0].(*TagCloud)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagCloud indicates an expected call of GetTagCloud
+func (mr *MockCouchConnectionsClientMockRecorder) GetTagCloud(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagCloud", reflect.TypeOf((*MockCouchConnectionsClient)(nil).GetTagCloud), varargs...)
+}
+
+// CreateFeedToken mocks base method
+func (m *MockCouchConnectionsClient) CreateFeedToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeedToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFeedToken", varargs...)
+	ret0, _ := ret[0].(*FeedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeedToken indicates an expected call of CreateFeedToken
+func (mr *MockCouchConnectionsClientMockRecorder) CreateFeedToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedToken", reflect.TypeOf((*MockCouchConnectionsClient)(nil).CreateFeedToken), varargs...)
+}
+
+// RevokeFeedToken mocks base method
+func (m *MockCouchConnectionsClient) RevokeFeedToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeFeedToken", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeFeedToken indicates an expected call of RevokeFeedToken
+func (mr *MockCouchConnectionsClientMockRecorder) RevokeFeedToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeedToken", reflect.TypeOf((*MockCouchConnectionsClient)(nil).RevokeFeedToken), varargs...)
+}
+
+// CreateAPIKey mocks base method
+func (m *MockCouchConnectionsClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKey", varargs...)
+	ret0, _ := ret[0].(*CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey
+func (mr *MockCouchConnectionsClientMockRecorder) CreateAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockCouchConnectionsClient)(nil).CreateAPIKey), varargs...)
+}
+
+// ListAPIKeys mocks base method
+func (m *MockCouchConnectionsClient) ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPIKeys", varargs...)
+	ret0, _ := ret[0].(*ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys
+func (mr *MockCouchConnectionsClientMockRecorder) ListAPIKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockCouchConnectionsClient)(nil).ListAPIKeys), varargs...)
+}
+
+// RevokeAPIKey mocks base method
+func (m *MockCouchConnectionsClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKey", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey
+func (mr *MockCouchConnectionsClientMockRecorder) RevokeAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockCouchConnectionsClient)(nil).RevokeAPIKey), varargs...)
+}
+
 // MockCouchConnectionsServer is a mock of CouchConnectionsServer interface
 type MockCouchConnectionsServer struct {
 	ctrl     *gomock.Controller
@@ -92,3 +632,408 @@ func (mr *MockCouchConnectionsServerMockRecorder) GetVersion(arg0, arg1 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockCouchConnectionsServer)(nil).GetVersion), arg0, arg1)
 }
+
+// CreateEvent mocks base method
+func (m *MockCouchConnectionsServer) CreateEvent(arg0 context.Context, arg1 *CreateEventRequest) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", arg0, arg1)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent
+func (mr *MockCouchConnectionsServerMockRecorder) CreateEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).CreateEvent), arg0, arg1)
+}
+
+// GetEvent mocks base method
+func (m *MockCouchConnectionsServer) GetEvent(arg0 context.Context, arg1 *GetEventRequest) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", arg0, arg1)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent
+func (mr *MockCouchConnectionsServerMockRecorder) GetEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).GetEvent), arg0, arg1)
+}
+
+// ListEvents mocks base method
+func (m *MockCouchConnectionsServer) ListEvents(arg0 context.Context, arg1 *ListEventsRequest) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", arg0, arg1)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents
+func (mr *MockCouchConnectionsServerMockRecorder) ListEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ListEvents), arg0, arg1)
+}
+
+// UpdateEvent mocks base method
+func (m *MockCouchConnectionsServer) UpdateEvent(arg0 context.Context, arg1 *UpdateEventRequest) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", arg0, arg1)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent
+func (mr *MockCouchConnectionsServerMockRecorder) UpdateEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).UpdateEvent), arg0, arg1)
+}
+
+// DeleteEvent mocks base method
+func (m *MockCouchConnectionsServer) DeleteEvent(arg0 context.Context, arg1 *DeleteEventRequest) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent
+func (mr *MockCouchConnectionsServerMockRecorder) DeleteEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).DeleteEvent), arg0, arg1)
+}
+
+// SearchEvents mocks base method
+func (m *MockCouchConnectionsServer) SearchEvents(arg0 context.Context, arg1 *SearchEventsRequest) (*SearchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", arg0, arg1)
+	ret0, _ := ret[0].(*SearchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents
+func (mr *MockCouchConnectionsServerMockRecorder) SearchEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockCouchConnectionsServer)(nil).SearchEvents), arg0, arg1)
+}
+
+// GetJoinLink mocks base method
+func (m *MockCouchConnectionsServer) GetJoinLink(arg0 context.Context, arg1 *GetJoinLinkRequest) (*JoinLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinLink", arg0, arg1)
+	ret0, _ := ret[0].(*JoinLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinLink indicates an expected call of GetJoinLink
+func (mr *MockCouchConnectionsServerMockRecorder) GetJoinLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinLink", reflect.TypeOf((*MockCouchConnectionsServer)(nil).GetJoinLink), arg0, arg1)
+}
+
+// RegisterForEvent mocks base method
+func (m *MockCouchConnectionsServer) RegisterForEvent(arg0 context.Context, arg1 *RegisterForEventRequest) (*Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterForEvent", arg0, arg1)
+	ret0, _ := ret[0].(*Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterForEvent indicates an expected call of RegisterForEvent
+func (mr *MockCouchConnectionsServerMockRecorder) RegisterForEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterForEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).RegisterForEvent), arg0, arg1)
+}
+
+// CancelRegistration mocks base method
+func (m *MockCouchConnectionsServer) CancelRegistration(arg0 context.Context, arg1 *CancelRegistrationRequest) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRegistration", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRegistration indicates an expected call of CancelRegistration
+func (mr *MockCouchConnectionsServerMockRecorder) CancelRegistration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRegistration", reflect.TypeOf((*MockCouchConnectionsServer)(nil).CancelRegistration), arg0, arg1)
+}
+
+// ListRegistrations mocks base method
+func (m *MockCouchConnectionsServer) ListRegistrations(arg0 context.Context, arg1 *ListRegistrationsRequest) (*ListRegistrationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistrations", arg0, arg1)
+	ret0, _ := ret[0].(*ListRegistrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistrations indicates an expected call of ListRegistrations
+func (mr *MockCouchConnectionsServerMockRecorder) ListRegistrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistrations", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ListRegistrations), arg0, arg1)
+}
+
+// ListPendingEvents mocks base method
+func (m *MockCouchConnectionsServer) ListPendingEvents(arg0 context.Context, arg1 *ListPendingEventsRequest) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingEvents", arg0, arg1)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingEvents indicates an expected call of ListPendingEvents
+func (mr *MockCouchConnectionsServerMockRecorder) ListPendingEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingEvents", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ListPendingEvents), arg0, arg1)
+}
+
+// ApproveEvent mocks base method
+func (m *MockCouchConnectionsServer) ApproveEvent(arg0 context.Context, arg1 *ApproveEventRequest) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveEvent", arg0, arg1)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveEvent indicates an expected call of ApproveEvent
+func (mr *MockCouchConnectionsServerMockRecorder) ApproveEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ApproveEvent), arg0, arg1)
+}
+
+// RejectEvent mocks base method
+func (m *MockCouchConnectionsServer) RejectEvent(arg0 context.Context, arg1 *RejectEventRequest) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectEvent", arg0, arg1)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectEvent indicates an expected call of RejectEvent
+func (mr *MockCouchConnectionsServerMockRecorder) RejectEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectEvent", reflect.TypeOf((*MockCouchConnectionsServer)(nil).RejectEvent), arg0, arg1)
+}
+
+// GetMyHostProfile mocks base method
+func (m *MockCouchConnectionsServer) GetMyHostProfile(arg0 context.Context, arg1 *empty.Empty) (*Host, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyHostProfile", arg0, arg1)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyHostProfile indicates an expected call of GetMyHostProfile
+func (mr *MockCouchConnectionsServerMockRecorder) GetMyHostProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyHostProfile", reflect.TypeOf((*MockCouchConnectionsServer)(nil).GetMyHostProfile), arg0, arg1)
+}
+
+// UpdateMyHostProfile mocks base method
+func (m *MockCouchConnectionsServer) UpdateMyHostProfile(arg0 context.Context, arg1 *UpdateMyHostProfileRequest) (*Host, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMyHostProfile", arg0, arg1)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMyHostProfile indicates an expected call of UpdateMyHostProfile
+func (mr *MockCouchConnectionsServerMockRecorder) UpdateMyHostProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMyHostProfile", reflect.TypeOf((*MockCouchConnectionsServer)(nil).UpdateMyHostProfile), arg0, arg1)
+}
+
+// GetHost mocks base method
+func (m *MockCouchConnectionsServer) GetHost(arg0 context.Context, arg1 *GetHostRequest) (*Host, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHost", arg0, arg1)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHost indicates an expected call of GetHost
+func (mr *MockCouchConnectionsServerMockRecorder) GetHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHost", reflect.TypeOf((*MockCouchConnectionsServer)(nil).GetHost), arg0, arg1)
+}
+
+// ListHostEvents mocks base method
+func (m *MockCouchConnectionsServer) ListHostEvents(arg0 context.Context, arg1 *ListHostEventsRequest) (*ListHostEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostEvents", arg0, arg1)
+	ret0, _ := ret[0].(*ListHostEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostEvents indicates an expected call of ListHostEvents
+func (mr *MockCouchConnectionsServerMockRecorder) ListHostEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostEvents", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ListHostEvents), arg0, arg1)
+}
+
+// ListCategories mocks base method
+func (m *MockCouchConnectionsServer) ListCategories(arg0 context.Context, arg1 *empty.Empty) (*ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", arg0, arg1)
+	ret0, _ := ret[0].(*ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories
+func (mr *MockCouchConnectionsServerMockRecorder) ListCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ListCategories), arg0, arg1)
+}
+
+// CreateCategory mocks base method
+func (m *MockCouchConnectionsServer) CreateCategory(arg0 context.Context, arg1 *CreateCategoryRequest) (*Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory
+func (mr *MockCouchConnectionsServerMockRecorder) CreateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCouchConnectionsServer)(nil).CreateCategory), arg0, arg1)
+}
+
+// UpdateCategory mocks base method
+func (m *MockCouchConnectionsServer) UpdateCategory(arg0 context.Context, arg1 *UpdateCategoryRequest) (*Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory
+func (mr *MockCouchConnectionsServerMockRecorder) UpdateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCouchConnectionsServer)(nil).UpdateCategory), arg0, arg1)
+}
+
+// DeleteCategory mocks base method
+func (m *MockCouchConnectionsServer) DeleteCategory(arg0 context.Context, arg1 *DeleteCategoryRequest) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory
+func (mr *MockCouchConnectionsServerMockRecorder) DeleteCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCouchConnectionsServer)(nil).DeleteCategory), arg0, arg1)
+}
+
+// GetTagCloud mocks base method
+func (m *MockCouchConnectionsServer) GetTagCloud(arg0 context.Context, arg1 *GetTagCloudRequest) (*TagCloud, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagCloud", arg0, arg1)
+	ret0, _ := ret[0].(*TagCloud)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagCloud indicates an expected call of GetTagCloud
+func (mr *MockCouchConnectionsServerMockRecorder) GetTagCloud(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagCloud", reflect.TypeOf((*MockCouchConnectionsServer)(nil).GetTagCloud), arg0, arg1)
+}
+
+// CreateFeedToken mocks base method
+func (m *MockCouchConnectionsServer) CreateFeedToken(arg0 context.Context, arg1 *empty.Empty) (*FeedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedToken", arg0, arg1)
+	ret0, _ := ret[0].(*FeedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeedToken indicates an expected call of CreateFeedToken
+func (mr *MockCouchConnectionsServerMockRecorder) CreateFeedToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedToken", reflect.TypeOf((*MockCouchConnectionsServer)(nil).CreateFeedToken), arg0, arg1)
+}
+
+// RevokeFeedToken mocks base method
+func (m *MockCouchConnectionsServer) RevokeFeedToken(arg0 context.Context, arg1 *empty.Empty) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFeedToken", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeFeedToken indicates an expected call of RevokeFeedToken
+func (mr *MockCouchConnectionsServerMockRecorder) RevokeFeedToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeedToken", reflect.TypeOf((*MockCouchConnectionsServer)(nil).RevokeFeedToken), arg0, arg1)
+}
+
+// CreateAPIKey mocks base method
+func (m *MockCouchConnectionsServer) CreateAPIKey(arg0 context.Context, arg1 *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey
+func (mr *MockCouchConnectionsServerMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockCouchConnectionsServer)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// ListAPIKeys mocks base method
+func (m *MockCouchConnectionsServer) ListAPIKeys(arg0 context.Context, arg1 *empty.Empty) (*ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(*ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys
+func (mr *MockCouchConnectionsServerMockRecorder) ListAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockCouchConnectionsServer)(nil).ListAPIKeys), arg0, arg1)
+}
+
+// RevokeAPIKey mocks base method
+func (m *MockCouchConnectionsServer) RevokeAPIKey(arg0 context.Context, arg1 *RevokeAPIKeyRequest) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey
+func (mr *MockCouchConnectionsServerMockRecorder) RevokeAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockCouchConnectionsServer)(nil).RevokeAPIKey), arg0, arg1)
+}
@@ -105,3 +105,3541 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = VersionValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Event) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetTopic()); l < 1 || l > 200 {
+		return EventValidationError{
+			field:  "Topic",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 5000 {
+		return EventValidationError{
+			field:  "Description",
+			reason: "value length must be at most 5000 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetHost()) > 200 {
+		return EventValidationError{
+			field:  "Host",
+			reason: "value length must be at most 200 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetZoomLink()) > 2000 {
+		return EventValidationError{
+			field:  "ZoomLink",
+			reason: "value length must be at most 2000 runes",
+		}
+	}
+
+	if !_Event_ZoomLink_Pattern.MatchString(m.GetZoomLink()) {
+		return EventValidationError{
+			field:  "ZoomLink",
+			reason: "value does not match regex pattern \"^(https?://[^\\\\s]+)?$\"",
+		}
+	}
+
+	if m.GetStart() == nil {
+		return EventValidationError{
+			field:  "Start",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTimeZone()) > 64 {
+		return EventValidationError{
+			field:  "TimeZone",
+			reason: "value length must be at most 64 runes",
+		}
+	}
+
+	if !_Event_Language_Pattern.MatchString(m.GetLanguage()) {
+		return EventValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^([a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*)?$\"",
+		}
+	}
+
+	if m.GetCapacity() > 100000 {
+		return EventValidationError{
+			field:  "Capacity",
+			reason: "value must be less than or equal to 100000",
+		}
+	}
+
+	if _, ok := EventStatus_name[int32(m.GetStatus())]; !ok {
+		return EventValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for OwnerId
+
+	if utf8.RuneCountInString(m.GetRecurrence()) > 500 {
+		return EventValidationError{
+			field:  "Recurrence",
+			reason: "value length must be at most 500 runes",
+		}
+	}
+
+	// no validation rules for SeriesId
+
+	if len(m.GetTags()) > 10 {
+		return EventValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			return EventValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+		}
+
+	}
+
+	// no validation rules for CategoryId
+
+	// no validation rules for HostId
+
+	if len(m.GetCoHostIds()) > 5 {
+		return EventValidationError{
+			field:  "CoHostIds",
+			reason: "value must contain no more than 5 item(s)",
+		}
+	}
+
+	// no validation rules for RejectionReason
+
+	return nil
+}
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+var _Event_ZoomLink_Pattern = regexp.MustCompile("^(https?://[^\\s]+)?$")
+
+var _Event_Language_Pattern = regexp.MustCompile("^([a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*)?$")
+
+// Validate checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetEvent() == nil {
+		return CreateEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IgnoreConflicts
+
+	return nil
+}
+
+// CreateEventRequestValidationError is the validation error returned by
+// CreateEventRequest.Validate if the designated constraints aren't met.
+type CreateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventRequestValidationError) ErrorName() string {
+	return "CreateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventRequestValidationError{}
+
+// Validate checks the field values on GetEventRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return GetEventRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetEventRequestValidationError is the validation error returned by
+// GetEventRequest.Validate if the designated constraints aren't met.
+type GetEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventRequestValidationError) ErrorName() string { return "GetEventRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventRequestValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListEventsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		return ListEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for Host
+
+	if _, ok := EventStatus_name[int32(m.GetStatus())]; !ok {
+		return ListEventsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Language
+
+	if _, ok := EventOrder_name[int32(m.GetOrderBy())]; !ok {
+		return ListEventsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Tag
+
+	// no validation rules for CategoryId
+
+	return nil
+}
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListEventsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsResponseValidationError{}
+
+// Validate checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return UpdateEventRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetEvent() == nil {
+		return UpdateEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := RecurrenceScope_name[int32(m.GetScope())]; !ok {
+		return UpdateEventRequestValidationError{
+			field:  "Scope",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for IgnoreConflicts
+
+	return nil
+}
+
+// UpdateEventRequestValidationError is the validation error returned by
+// UpdateEventRequest.Validate if the designated constraints aren't met.
+type UpdateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateEventRequestValidationError) ErrorName() string {
+	return "UpdateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateEventRequestValidationError{}
+
+// Validate checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DeleteEventRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if _, ok := RecurrenceScope_name[int32(m.GetScope())]; !ok {
+		return DeleteEventRequestValidationError{
+			field:  "Scope",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// DeleteEventRequestValidationError is the validation error returned by
+// DeleteEventRequest.Validate if the designated constraints aren't met.
+type DeleteEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEventRequestValidationError) ErrorName() string {
+	return "DeleteEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEventRequestValidationError{}
+
+// Validate checks the field values on SearchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchEventsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 200 {
+		return SearchEventsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		return SearchEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+	}
+
+	return nil
+}
+
+// SearchEventsRequestValidationError is the validation error returned by
+// SearchEventsRequest.Validate if the designated constraints aren't met.
+type SearchEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchEventsRequestValidationError) ErrorName() string {
+	return "SearchEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEventsRequestValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SearchResult) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchResultValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Highlight) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Field
+
+	// no validation rules for Snippet
+
+	return nil
+}
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on SearchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchEventsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchEventsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SearchEventsResponseValidationError is the validation error returned by
+// SearchEventsResponse.Validate if the designated constraints aren't met.
+type SearchEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchEventsResponseValidationError) ErrorName() string {
+	return "SearchEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEventsResponseValidationError{}
+
+// Validate checks the field values on Category with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Category) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		return CategoryValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		return CategoryValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+	}
+
+	return nil
+}
+
+// CategoryValidationError is the validation error returned by
+// Category.Validate if the designated constraints aren't met.
+type CategoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryValidationError) ErrorName() string { return "CategoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryValidationError{}
+
+// Validate checks the field values on CreateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateCategoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetCategory() == nil {
+		return CreateCategoryRequestValidationError{
+			field:  "Category",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCategoryRequestValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateCategoryRequestValidationError is the validation error returned by
+// CreateCategoryRequest.Validate if the designated constraints aren't met.
+type CreateCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCategoryRequestValidationError) ErrorName() string {
+	return "CreateCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCategoryRequestValidationError{}
+
+// Validate checks the field values on UpdateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateCategoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return UpdateCategoryRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetCategory() == nil {
+		return UpdateCategoryRequestValidationError{
+			field:  "Category",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCategoryRequestValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateCategoryRequestValidationError is the validation error returned by
+// UpdateCategoryRequest.Validate if the designated constraints aren't met.
+type UpdateCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCategoryRequestValidationError) ErrorName() string {
+	return "UpdateCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCategoryRequestValidationError{}
+
+// Validate checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteCategoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DeleteCategoryRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// DeleteCategoryRequestValidationError is the validation error returned by
+// DeleteCategoryRequest.Validate if the designated constraints aren't met.
+type DeleteCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryRequestValidationError) ErrorName() string {
+	return "DeleteCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryRequestValidationError{}
+
+// Validate checks the field values on ListCategoriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListCategoriesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCategoriesResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListCategoriesResponseValidationError is the validation error returned by
+// ListCategoriesResponse.Validate if the designated constraints aren't met.
+type ListCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoriesResponseValidationError) ErrorName() string {
+	return "ListCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoriesResponseValidationError{}
+
+// Validate checks the field values on GetTagCloudRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetTagCloudRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 200 {
+		return GetTagCloudRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 200]",
+		}
+	}
+
+	return nil
+}
+
+// GetTagCloudRequestValidationError is the validation error returned by
+// GetTagCloudRequest.Validate if the designated constraints aren't met.
+type GetTagCloudRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagCloudRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagCloudRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagCloudRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagCloudRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagCloudRequestValidationError) ErrorName() string {
+	return "GetTagCloudRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagCloudRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagCloudRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagCloudRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagCloudRequestValidationError{}
+
+// Validate checks the field values on TagCount with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TagCount) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Tag
+
+	// no validation rules for Count
+
+	return nil
+}
+
+// TagCountValidationError is the validation error returned by
+// TagCount.Validate if the designated constraints aren't met.
+type TagCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagCountValidationError) ErrorName() string { return "TagCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagCountValidationError{}
+
+// Validate checks the field values on TagCloud with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TagCloud) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TagCloudValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TagCloudValidationError is the validation error returned by
+// TagCloud.Validate if the designated constraints aren't met.
+type TagCloudValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagCloudValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagCloudValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagCloudValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagCloudValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagCloudValidationError) ErrorName() string { return "TagCloudValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagCloudValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagCloud.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagCloudValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagCloudValidationError{}
+
+// Validate checks the field values on Host with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Host) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	if utf8.RuneCountInString(m.GetName()) > 200 {
+		return HostValidationError{
+			field:  "Name",
+			reason: "value length must be at most 200 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetBio()) > 2000 {
+		return HostValidationError{
+			field:  "Bio",
+			reason: "value length must be at most 2000 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetAvatarUrl()) > 2000 {
+		return HostValidationError{
+			field:  "AvatarUrl",
+			reason: "value length must be at most 2000 runes",
+		}
+	}
+
+	if !_Host_AvatarUrl_Pattern.MatchString(m.GetAvatarUrl()) {
+		return HostValidationError{
+			field:  "AvatarUrl",
+			reason: "value does not match regex pattern \"^(https?://[^\\\\s]+)?$\"",
+		}
+	}
+
+	if len(m.GetLinks()) > 10 {
+		return HostValidationError{
+			field:  "Links",
+			reason: "value must contain no more than 10 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetLinks() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HostValidationError{
+					field:  fmt.Sprintf("Links[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// HostValidationError is the validation error returned by Host.Validate if the
+// designated constraints aren't met.
+type HostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostValidationError) ErrorName() string { return "HostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostValidationError{}
+
+var _Host_AvatarUrl_Pattern = regexp.MustCompile("^(https?://[^\\s]+)?$")
+
+// Validate checks the field values on HostLink with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *HostLink) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 100 {
+		return HostLinkValidationError{
+			field:  "Title",
+			reason: "value length must be at most 100 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2000 {
+		return HostLinkValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2000 runes",
+		}
+	}
+
+	if !_HostLink_Url_Pattern.MatchString(m.GetUrl()) {
+		return HostLinkValidationError{
+			field:  "Url",
+			reason: "value does not match regex pattern \"^https?://[^\\\\s]+$\"",
+		}
+	}
+
+	return nil
+}
+
+// HostLinkValidationError is the validation error returned by
+// HostLink.Validate if the designated constraints aren't met.
+type HostLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostLinkValidationError) ErrorName() string { return "HostLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HostLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostLinkValidationError{}
+
+var _HostLink_Url_Pattern = regexp.MustCompile("^https?://[^\\s]+$")
+
+// Validate checks the field values on UpdateMyHostProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateMyHostProfileRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetHost() == nil {
+		return UpdateMyHostProfileRequestValidationError{
+			field:  "Host",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMyHostProfileRequestValidationError{
+				field:  "Host",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateMyHostProfileRequestValidationError is the validation error returned
+// by UpdateMyHostProfileRequest.Validate if the designated constraints aren't met.
+type UpdateMyHostProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMyHostProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMyHostProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMyHostProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMyHostProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMyHostProfileRequestValidationError) ErrorName() string {
+	return "UpdateMyHostProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMyHostProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMyHostProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMyHostProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMyHostProfileRequestValidationError{}
+
+// Validate checks the field values on GetHostRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *GetHostRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return GetHostRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetHostRequestValidationError is the validation error returned by
+// GetHostRequest.Validate if the designated constraints aren't met.
+type GetHostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHostRequestValidationError) ErrorName() string { return "GetHostRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetHostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHostRequestValidationError{}
+
+// Validate checks the field values on ListHostEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListHostEventsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return ListHostEventsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		return ListHostEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+	}
+
+	return nil
+}
+
+// ListHostEventsRequestValidationError is the validation error returned by
+// ListHostEventsRequest.Validate if the designated constraints aren't met.
+type ListHostEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHostEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHostEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHostEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHostEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHostEventsRequestValidationError) ErrorName() string {
+	return "ListHostEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHostEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHostEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHostEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHostEventsRequestValidationError{}
+
+// Validate checks the field values on ListHostEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListHostEventsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListHostEventsResponseValidationError{
+				field:  "Host",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetUpcoming() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHostEventsResponseValidationError{
+					field:  fmt.Sprintf("Upcoming[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPast() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHostEventsResponseValidationError{
+					field:  fmt.Sprintf("Past[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListHostEventsResponseValidationError is the validation error returned by
+// ListHostEventsResponse.Validate if the designated constraints aren't met.
+type ListHostEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHostEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHostEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHostEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHostEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHostEventsResponseValidationError) ErrorName() string {
+	return "ListHostEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHostEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHostEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHostEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHostEventsResponseValidationError{}
+
+// Validate checks the field values on ListPendingEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListPendingEventsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		return ListPendingEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListPendingEventsRequestValidationError is the validation error returned by
+// ListPendingEventsRequest.Validate if the designated constraints aren't met.
+type ListPendingEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingEventsRequestValidationError) ErrorName() string {
+	return "ListPendingEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingEventsRequestValidationError{}
+
+// Validate checks the field values on ApproveEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApproveEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return ApproveEventRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// ApproveEventRequestValidationError is the validation error returned by
+// ApproveEventRequest.Validate if the designated constraints aren't met.
+type ApproveEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveEventRequestValidationError) ErrorName() string {
+	return "ApproveEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveEventRequestValidationError{}
+
+// Validate checks the field values on RejectEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RejectEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return RejectEventRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1000 {
+		return RejectEventRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1000 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// RejectEventRequestValidationError is the validation error returned by
+// RejectEventRequest.Validate if the designated constraints aren't met.
+type RejectEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectEventRequestValidationError) ErrorName() string {
+	return "RejectEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectEventRequestValidationError{}
+
+// Validate checks the field values on GetJoinLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetJoinLinkRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return GetJoinLinkRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetJoinLinkRequestValidationError is the validation error returned by
+// GetJoinLinkRequest.Validate if the designated constraints aren't met.
+type GetJoinLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJoinLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJoinLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJoinLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJoinLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJoinLinkRequestValidationError) ErrorName() string {
+	return "GetJoinLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJoinLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJoinLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJoinLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJoinLinkRequestValidationError{}
+
+// Validate checks the field values on JoinLink with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *JoinLink) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for EventId
+
+	// no validation rules for ZoomLink
+
+	return nil
+}
+
+// JoinLinkValidationError is the validation error returned by
+// JoinLink.Validate if the designated constraints aren't met.
+type JoinLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinLinkValidationError) ErrorName() string { return "JoinLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JoinLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinLinkValidationError{}
+
+// Validate checks the field values on Registration with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Registration) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for EventId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserName
+
+	// no validation rules for Status
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RegistrationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RegistrationValidationError is the validation error returned by
+// Registration.Validate if the designated constraints aren't met.
+type RegistrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationValidationError) ErrorName() string { return "RegistrationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegistrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationValidationError{}
+
+// Validate checks the field values on RegisterForEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RegisterForEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetEventId()) < 1 {
+		return RegisterForEventRequestValidationError{
+			field:  "EventId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// RegisterForEventRequestValidationError is the validation error returned by
+// RegisterForEventRequest.Validate if the designated constraints aren't met.
+type RegisterForEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterForEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterForEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterForEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterForEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterForEventRequestValidationError) ErrorName() string {
+	return "RegisterForEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterForEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterForEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterForEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterForEventRequestValidationError{}
+
+// Validate checks the field values on CancelRegistrationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CancelRegistrationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetEventId()) < 1 {
+		return CancelRegistrationRequestValidationError{
+			field:  "EventId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// CancelRegistrationRequestValidationError is the validation error returned by
+// CancelRegistrationRequest.Validate if the designated constraints aren't met.
+type CancelRegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelRegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelRegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelRegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelRegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelRegistrationRequestValidationError) ErrorName() string {
+	return "CancelRegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelRegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelRegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelRegistrationRequestValidationError{}
+
+// Validate checks the field values on ListRegistrationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRegistrationsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetEventId()) < 1 {
+		return ListRegistrationsRequestValidationError{
+			field:  "EventId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// ListRegistrationsRequestValidationError is the validation error returned by
+// ListRegistrationsRequest.Validate if the designated constraints aren't met.
+type ListRegistrationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegistrationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegistrationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegistrationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegistrationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegistrationsRequestValidationError) ErrorName() string {
+	return "ListRegistrationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegistrationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegistrationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegistrationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegistrationsRequestValidationError{}
+
+// Validate checks the field values on ListRegistrationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRegistrationsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRegistrations() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRegistrationsResponseValidationError{
+					field:  fmt.Sprintf("Registrations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListRegistrationsResponseValidationError is the validation error returned by
+// ListRegistrationsResponse.Validate if the designated constraints aren't met.
+type ListRegistrationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegistrationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegistrationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegistrationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegistrationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegistrationsResponseValidationError) ErrorName() string {
+	return "ListRegistrationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegistrationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegistrationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegistrationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegistrationsResponseValidationError{}
+
+// Validate checks the field values on FeedToken with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *FeedToken) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Token
+
+	// no validation rules for Path
+
+	return nil
+}
+
+// FeedTokenValidationError is the validation error returned by
+// FeedToken.Validate if the designated constraints aren't met.
+type FeedTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeedTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeedTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeedTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeedTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeedTokenValidationError) ErrorName() string { return "FeedTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeedTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeedToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeedTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeedTokenValidationError{}
+
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *APIKey) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		return APIKeyValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Prefix
+
+	if l := len(m.GetPermissions()); l < 1 || l > 20 {
+		return APIKeyValidationError{
+			field:  "Permissions",
+			reason: "value must contain between 1 and 20 items, inclusive",
+		}
+	}
+
+	_APIKey_Permissions_Unique := make(map[string]struct{}, len(m.GetPermissions()))
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if _, exists := _APIKey_Permissions_Unique[item]; exists {
+			return APIKeyValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_APIKey_Permissions_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Permissions[idx]
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAPIKeyRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetApiKey() == nil {
+		return CreateAPIKeyRequestValidationError{
+			field:  "ApiKey",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyRequestValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyRequestValidationError{}
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAPIKeyResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	return nil
+}
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAPIKeysResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetApiKeys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("ApiKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListAPIKeysResponseValidationError is the validation error returned by
+// ListAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysResponseValidationError) ErrorName() string {
+	return "ListAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return RevokeAPIKeyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyRequestValidationError{}